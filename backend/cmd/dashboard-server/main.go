@@ -7,10 +7,15 @@ import (
 	"github.com/piper-hyowon/dBtree/internal/auth"
 	authRest "github.com/piper-hyowon/dBtree/internal/auth/rest"
 	coreauth "github.com/piper-hyowon/dBtree/internal/core/auth"
+	coredbservice "github.com/piper-hyowon/dBtree/internal/core/dbservice"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
 	"github.com/piper-hyowon/dBtree/internal/dbservice"
+	_ "github.com/piper-hyowon/dBtree/internal/dbservice/engine/mongodb" // DB 엔진 등록
+	_ "github.com/piper-hyowon/dBtree/internal/dbservice/engine/redis"
 	dbsRest "github.com/piper-hyowon/dBtree/internal/dbservice/rest"
 	"github.com/piper-hyowon/dBtree/internal/email"
+	"github.com/piper-hyowon/dBtree/internal/job"
+	jobRest "github.com/piper-hyowon/dBtree/internal/job/rest"
 	"github.com/piper-hyowon/dBtree/internal/lemon"
 	lemonRest "github.com/piper-hyowon/dBtree/internal/lemon/rest"
 	"github.com/piper-hyowon/dBtree/internal/platform/k8s"
@@ -87,6 +92,8 @@ func main() {
 	dbiStore := dbservice.NewDBIStore(appConfig.UseLocalMemoryStore, pgClient.DB())
 	presetStore := dbservice.NewPresetStore(appConfig.UseLocalMemoryStore, pgClient.DB())
 	portStore := dbservice.NewPortStore(appConfig.UseLocalMemoryStore, pgClient.DB())
+	sagaStore := dbservice.NewProvisionSagaStore(appConfig.UseLocalMemoryStore, pgClient.DB())
+	jobStore := job.NewJobStore(appConfig.UseLocalMemoryStore, pgClient.DB())
 
 	lemonService := lemon.NewService(lemonStore, quizStore, logger)
 
@@ -100,7 +107,8 @@ func main() {
 	)
 
 	authHandler := authRest.NewHandler(authService, logger)
-	authMiddleware := rest.NewAuthMiddleware(authService, logger)
+	authMiddleware := rest.NewAuthMiddleware(authService, appConfig.AdminEmail, logger)
+	idempotency := rest.NewIdempotencyMiddleware(redis.NewIdempotencyStore(redisClient.Redis()), logger)
 
 	userService := user.NewService(
 		emailService,
@@ -119,9 +127,31 @@ func main() {
 	resourceManager := resource.NewManager(dbiStore, logger)
 	resourceHandler := resourceRest.NewHandler(resourceManager, logger)
 
-	dbsService := dbservice.NewService(appConfig.Server.PublicDBHost, dbiStore, presetStore, lemonService,
-		userStore, k8sClient, portStore, resourceManager, logger)
-	dbsHandler := dbsRest.NewHandler(appConfig.Server.PublicDBHost, dbsService, portStore, logger)
+	dbAccess := coredbservice.ExternalAccess{
+		PublicHost:      appConfig.Server.PublicDBHost,
+		GatewayDomain:   appConfig.Server.DBGatewayDomain,
+		GatewayPort:     appConfig.Server.DBGatewayPort,
+		NodePortEnabled: appConfig.Server.DBNodePortEnabled,
+	}
+	importStager, err := dbservice.NewImportStager(appConfig.Import.StagingDir, appConfig.Import.InternalBaseURL)
+	if err != nil {
+		logger.Fatalf("가져오기 임시 저장소 초기화 실패: %v", err)
+	}
+
+	backupSigner, err := dbservice.NewBackupURLSigner(appConfig.BackupDownload.SigningKey,
+		appConfig.BackupDownload.URLTTL, appConfig.BackupDownload.PublicBaseURL, logger)
+	if err != nil {
+		logger.Fatalf("백업 다운로드 서명 초기화 실패: %v", err)
+	}
+
+	jobQueue := job.NewQueue(jobStore, logger, 4, 2*time.Second)
+	jobHandler := jobRest.NewHandler(jobQueue, logger)
+
+	dbsService := dbservice.NewService(dbAccess, dbiStore, presetStore, lemonService,
+		userStore, k8sClient, portStore, sagaStore, jobQueue, resourceManager, importStager, backupSigner,
+		appConfig.RecycleBin.Retention, logger)
+	dbsHandler := dbsRest.NewHandler(dbAccess, dbsService, portStore,
+		rest.NewClientIPResolver(appConfig.Server.TrustedProxies), logger)
 
 	statsService := stats.NewService(lemonStore, userStore, dbiStore, quizStore, logger)
 	statsHandler := statsRest.NewHandler(statsService, logger)
@@ -130,12 +160,46 @@ func main() {
 
 	r.GET("/system/resources", resourceHandler.GetSystemResources)
 
-	r.POST("/db/instances", authMiddleware.RequireAuth(dbsHandler.CreateInstance))
+	r.POST("/db/instances", authMiddleware.RequireAuth(idempotency.Idempotent(dbsHandler.CreateInstance)))
 	r.GET("/db/instances", authMiddleware.RequireAuth(dbsHandler.ListInstances))
 	r.GET("/db/instances/:id", authMiddleware.RequireAuth(dbsHandler.GetInstanceWithSync))
+	r.PATCH("/db/instances/:id", authMiddleware.RequireAuth(dbsHandler.UpdateInstance))
+	r.GET("/db/instances/:id/events", authMiddleware.RequireAuth(dbsHandler.ListInstanceEvents))
+	r.GET("/db/instances/:id/logs", authMiddleware.RequireAuth(dbsHandler.StreamInstanceLogs))
+	r.GET("/db/instances/:id/allowlist", authMiddleware.RequireAuth(dbsHandler.GetAllowlist))
+	r.PUT("/db/instances/:id/metadata", authMiddleware.RequireAuth(dbsHandler.UpdateMetadata))
+	r.PUT("/db/instances/:id/allowlist", authMiddleware.RequireAuth(dbsHandler.UpdateAllowlist))
+	r.PUT("/db/instances/:id/profiling", authMiddleware.RequireAuth(dbsHandler.UpdateProfiling))
+	r.PUT("/db/instances/:id/idle-policy", authMiddleware.RequireAuth(dbsHandler.UpdateIdlePolicy))
+	r.GET("/db/instances/:id/schedule", authMiddleware.RequireAuth(dbsHandler.GetSchedule))
+	r.PUT("/db/instances/:id/schedule", authMiddleware.RequireAuth(dbsHandler.UpdateSchedule))
+	r.DELETE("/db/instances/:id/schedule", authMiddleware.RequireAuth(dbsHandler.DeleteSchedule))
+	r.GET("/db/instances/:id/slow-queries", authMiddleware.RequireAuth(dbsHandler.SlowQueries))
+	r.POST("/db/instances/:id/console", authMiddleware.RequireAuth(dbsHandler.RunConsoleCommand))
+	r.POST("/db/instances/:id/import", authMiddleware.RequireAuth(dbsHandler.StartImport))
+	r.GET("/db/instances/:id/imports", authMiddleware.RequireAuth(dbsHandler.ListImports))
+	r.GET("/db/instances/:id/imports/:importId", authMiddleware.RequireAuth(dbsHandler.GetImport))
+	r.GET("/db/instances/:id/backups/:backupId/download", authMiddleware.RequireAuth(dbsHandler.GetBackupDownloadURL))
+	r.POST("/db/instances/:id/credentials/rotate", authMiddleware.RequireAuth(dbsHandler.RotateCredentials))
 	r.DELETE("/db/instances/:id", authMiddleware.RequireAuth(dbsHandler.DeleteInstance))
-	r.POST("/db/instances/:id/:status", authMiddleware.RequireAuth(dbsHandler.UpdateInstanceStatus))
+	r.POST("/db/instances/:id/undelete", authMiddleware.RequireAuth(dbsHandler.UndeleteInstance))
+	r.POST("/db/instances/:id/:status", authMiddleware.RequireAuth(idempotency.Idempotent(dbsHandler.UpdateInstanceStatus)))
 	r.GET("/db/presets", dbsHandler.ListPresets)
+	r.POST("/db/presets", authMiddleware.RequireAuth(dbsHandler.CreatePreset))
+	r.GET("/db/presets/mine", authMiddleware.RequireAuth(dbsHandler.ListUserPresets))
+	r.GET("/db/presets/pending", authMiddleware.RequireAdmin(dbsHandler.ListPendingPresets))
+	r.DELETE("/db/presets/:id", authMiddleware.RequireAuth(dbsHandler.DeletePreset))
+	r.POST("/db/presets/:id/publish", authMiddleware.RequireAuth(dbsHandler.PublishPreset))
+	r.POST("/db/presets/:id/moderate", authMiddleware.RequireAdmin(dbsHandler.ModeratePreset))
+	r.POST("/db/quotes", authMiddleware.RequireAuth(dbsHandler.QuoteInstance))
+	r.GET("/db/sample-datasets", dbsHandler.ListSampleDatasets)
+
+	// import Job 전용, 세션 대신 가져오기 요청별 일회용 토큰으로 인증
+	r.GET("/internal/imports/:importId/payload", dbsHandler.DownloadImportPayload)
+	// 서명된 백업 다운로드 주소, 브라우저가 세션 헤더 없이 바로 내려받음
+	r.GET("/backups/:backupId/archive", dbsHandler.DownloadBackupArchive)
+
+	r.GET("/jobs/:id", authMiddleware.RequireAuth(jobHandler.GetJob))
 
 	r.POST("/verify-otp", func(w http.ResponseWriter, r *http.Request) {
 		otpType := r.URL.Query().Get("type")
@@ -163,7 +227,7 @@ func main() {
 
 	r.GET("/lemon/global-status", lemonHandler.TreeStatus)
 	r.GET("/lemon/harvestable", authMiddleware.RequireAuth(lemonHandler.CanHarvest))
-	r.POST("/lemon/harvest", authMiddleware.RequireAuth(lemonHandler.HarvestLemon))
+	r.POST("/lemon/harvest", authMiddleware.RequireAuth(idempotency.Idempotent(lemonHandler.HarvestLemon)))
 
 	r.GET("/quiz/:positionID", authMiddleware.RequireAuth(func(w http.ResponseWriter, r *http.Request) {
 		positionID := router.Param(r, "positionID")
@@ -290,8 +354,30 @@ func main() {
 		1*time.Hour, // 1시간마다 실행
 	)
 
+	powerScheduler := scheduler.NewPowerScheduler(dbsService, logger, 1*time.Minute)
+	recycleBinReaper := scheduler.NewRecycleBinReaper(dbsService, logger, 10*time.Minute)
+	idleScheduler := scheduler.NewIdleScheduler(dbsService, userStore, emailService,
+		appConfig.DashboardBaseURL, logger, 10*time.Minute)
+
+	statusSyncer := dbservice.NewStatusSyncer(k8sClient, dbiStore, importStager, logger)
+	if err := statusSyncer.Start(); err != nil {
+		logger.Printf("상태 동기화 시작 실패: %v", err)
+	}
+
+	provisionWorker, err := dbservice.NewProvisionWorker(dbsService, lemonService, logger, 1*time.Minute)
+	if err != nil {
+		logger.Fatalf("인스턴스 생성 복구 워커 초기화 실패: %v", err)
+	}
+
 	lemonScheduler.Start()
 	billingScheduler.Start()
+	powerScheduler.Start()
+	idleScheduler.Start()
+	recycleBinReaper.Start()
+	provisionWorker.Start()
+	if err := jobQueue.Start(); err != nil {
+		logger.Fatalf("작업 큐 시작 실패: %v", err)
+	}
 
 	// 종료 시그널
 	stopChan := make(chan os.Signal, 1)
@@ -308,9 +394,21 @@ func main() {
 	logger.Println("종료 신호 수신")
 	lemonScheduler.Stop()
 	billingScheduler.Stop()
+	powerScheduler.Stop()
+	idleScheduler.Stop()
+	recycleBinReaper.Stop()
+	provisionWorker.Stop()
+	statusSyncer.Stop()
+
+	shutdownErr := server.GracefulShutdown(5 * time.Second)
+
+	// 새 요청이 없는 상태에서 실행 중인 작업 마무리, 시간 안에 끝나지 않은 작업은 다음 서버가 다시 실행
+	if err := jobQueue.Drain(30 * time.Second); err != nil {
+		logger.Printf("작업 큐 종료 중 오류: %v", err)
+	}
 
-	if err := server.GracefulShutdown(5 * time.Second); err != nil {
-		logger.Fatalf("서버 종료 중 오류: %v", err)
+	if shutdownErr != nil {
+		logger.Fatalf("서버 종료 중 오류: %v", shutdownErr)
 	}
 }
 
@@ -0,0 +1,48 @@
+package dbservice
+
+// ExternalAccess 외부 접속 방식 설정
+// GatewayDomain 이 있으면 공용 TLS 게이트웨이가 SNI 호스트명(<externalId>.<GatewayDomain>)으로
+// 인스턴스 Service 에 라우팅하고, 인스턴스별 NodePort 는 NodePortEnabled 일 때만 할당
+type ExternalAccess struct {
+	PublicHost      string // NodePort 접속 호스트
+	GatewayDomain   string // 예: db.dbtree.cloud
+	GatewayPort     int
+	NodePortEnabled bool
+}
+
+// ExternalEndpoint 클라이언트가 접속할 주소
+type ExternalEndpoint struct {
+	Host string
+	Port int
+	TLS  bool
+}
+
+func (a ExternalAccess) GatewayEnabled() bool {
+	return a.GatewayDomain != ""
+}
+
+// GatewayHost 인스턴스의 SNI 호스트명, 게이트웨이를 쓰지 않으면 빈 문자열
+func (a ExternalAccess) GatewayHost(externalID string) string {
+	if !a.GatewayEnabled() {
+		return ""
+	}
+	return externalID + "." + a.GatewayDomain
+}
+
+// Endpoint 외부 접속 주소 (게이트웨이 우선), 접속 수단이 없으면 nil
+func (a ExternalAccess) Endpoint(externalID string, nodePort int) *ExternalEndpoint {
+	if a.GatewayEnabled() {
+		return &ExternalEndpoint{
+			Host: a.GatewayHost(externalID),
+			Port: a.GatewayPort,
+			TLS:  true,
+		}
+	}
+	if nodePort > 0 {
+		return &ExternalEndpoint{
+			Host: a.PublicHost,
+			Port: nodePort,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package dbservice
+
+import (
+	"io"
+	"time"
+)
+
+// BackupDownload 짧은 시간만 유효한 서명된 백업 다운로드 주소
+type BackupDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SignedBackupURL 다운로드 주소에 담긴 서명 파라미터
+type SignedBackupURL struct {
+	BackupID  string
+	UserID    string // 링크를 발급받은 사용자
+	Expires   int64  // unix seconds
+	Signature string
+}
+
+// BackupArchive 다운로드 응답으로 스트리밍할 아카이브, Body 는 호출자가 닫음
+type BackupArchive struct {
+	Body      io.ReadCloser
+	FileName  string
+	SizeBytes int64 // 파드에서 확인한 크기, Body 가 이만큼 오지 않으면 Read 가 에러
+}
+
+type BackupDownloadAction string
+
+const (
+	BackupDownloadIssued     BackupDownloadAction = "issued"     // 서명된 주소 발급
+	BackupDownloadDownloaded BackupDownloadAction = "downloaded" // 서명 검증 후 전송 시작
+)
+
+// BackupDownloadAudit 백업 다운로드 감사 기록
+type BackupDownloadAudit struct {
+	BackupID  int64
+	UserID    string
+	Action    BackupDownloadAction
+	ClientIP  string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+// ClientInfo 감사 기록용 요청자 정보
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
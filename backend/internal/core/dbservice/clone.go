@@ -0,0 +1,7 @@
+package dbservice
+
+// Cloner 다른 인스턴스의 데이터로 새 인스턴스를 채울 수 있는 엔진
+type Cloner interface {
+	// ValidateClone source 를 복제할 수 있는지 확인, fromBackup 이면 백업 아카이브에서 복원
+	ValidateClone(source *DBInstance, fromBackup bool) error
+}
@@ -0,0 +1,37 @@
+package dbservice
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	DefaultConsoleLimit = 20
+	MaxConsoleLimit     = 100 // 한 번에 반환하는 문서/항목 수
+
+	// MaxConsoleResultBytes 결과 JSON 최대 크기, 넘으면 MongoDB 는 잘라서 반환하고 Redis 는 에러
+	MaxConsoleResultBytes = 256 * 1024
+	ConsoleTimeout        = 10 * time.Second
+)
+
+// ConsoleRequestEnv 콘솔 요청(JSON)을 클라이언트 파드에 전달하는 환경 변수
+// 스크립트에 요청을 문자열로 끼워 넣지 않기 위함
+const ConsoleRequestEnv = "CONSOLE_REQUEST"
+
+// Console 읽기 전용 쿼리 콘솔을 지원하는 엔진이 구현
+type Console interface {
+	// ConsoleCommand 허용된 읽기 명령인지 검증하고 클라이언트 명령으로 변환
+	// 접속 정보는 DB_HOST, DB_PORT 와 인스턴스 Secret, 요청은 ConsoleRequestEnv 로 전달됨
+	ConsoleCommand(req *ConsoleRequest) ([]string, error)
+
+	// ParseConsoleOutput 클라이언트 출력을 결과로 변환, DB 가 돌려준 에러는 CommandFailed
+	ParseConsoleOutput(req *ConsoleRequest, output []byte) (*ConsoleResult, error)
+}
+
+type ConsoleResult struct {
+	Command   string          `json:"command"`
+	Result    json.RawMessage `json:"result"`          // 문서 배열 또는 단일 값
+	Count     *int            `json:"count,omitempty"` // 반환한 문서 수 (find, aggregate)
+	Truncated bool            `json:"truncated"`       // 크기 제한으로 잘렸는지
+	ElapsedMs int64           `json:"elapsedMs"`
+}
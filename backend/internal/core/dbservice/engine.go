@@ -0,0 +1,109 @@
+package dbservice
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Engine 데이터베이스 엔진별 동작 정의
+// 엔진 패키지가 init()에서 RegisterEngine 을 호출하므로 새 엔진은 패키지 하나만 추가하면 됨
+type Engine interface {
+	Type() DBType
+
+	// Available 생성 가능 여부, 지원 전인 엔진은 사유를 담은 에러 반환
+	Available() error
+
+	// Modes 지원 모드 목록, 첫 번째가 기본 모드
+	Modes() []DBMode
+
+	// DefaultPort 클러스터 내부 포트
+	DefaultPort() int
+
+	DefaultConfig(mode DBMode) map[string]interface{}
+	ValidateConfig(mode DBMode, config map[string]interface{}, resources *ResourceSpec) error
+
+	// MemoryCost 메모리 기반 시간당 기본 비용(레몬), CPU/디스크 추가 비용은 CalculateCustomCost 에서 공통 계산
+	MemoryCost(memoryMB int) float64
+
+	// SecretData K8s Secret 에 저장할 접속 정보
+	SecretData(username, password string) map[string][]byte
+
+	// ClientImage 클러스터 안에서 명령을 실행할 클라이언트 이미지 (mongosh, redis-cli)
+	ClientImage() string
+
+	// ConnectionURI 외부 접속 URI, tls 는 게이트웨이 경유 접속
+	ConnectionURI(username, password, host string, port int, database string, tls bool) string
+}
+
+var (
+	enginesMu sync.RWMutex
+	engines   = make(map[DBType]Engine)
+)
+
+// RegisterEngine 엔진 등록, 같은 타입을 두 번 등록하면 panic
+func RegisterEngine(engine Engine) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+
+	if engine == nil {
+		panic("dbservice: RegisterEngine engine is nil")
+	}
+	if _, dup := engines[engine.Type()]; dup {
+		panic(fmt.Sprintf("dbservice: RegisterEngine called twice for %s", engine.Type()))
+	}
+	engines[engine.Type()] = engine
+}
+
+func LookupEngine(dbType DBType) (Engine, bool) {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+
+	engine, ok := engines[dbType]
+	return engine, ok
+}
+
+// Engines 등록된 엔진 목록 (타입순)
+func Engines() []Engine {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+
+	list := make([]Engine, 0, len(engines))
+	for _, engine := range engines {
+		list = append(list, engine)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Type() < list[j].Type()
+	})
+	return list
+}
+
+// AvailableEngines 현재 생성 가능한 엔진 목록
+func AvailableEngines() []Engine {
+	var list []Engine
+	for _, engine := range Engines() {
+		if engine.Available() == nil {
+			list = append(list, engine)
+		}
+	}
+	return list
+}
+
+// SupportsMode 엔진이 해당 모드를 지원하는지 확인
+func SupportsMode(engine Engine, mode DBMode) bool {
+	for _, m := range engine.Modes() {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func joinModes(modes []DBMode) string {
+	names := make([]string, len(modes))
+	for i, mode := range modes {
+		names[i] = string(mode)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,71 @@
+package dbservice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// IdlePausedReason 유휴 자동 중지된 인스턴스의 status_reason
+// 레몬 부족 일시정지(StatusPaused)와 달리 StatusStopped 로 두어 과금 스케줄러의 삭제 대상이 되지 않음
+const IdlePausedReason = "idle_auto_paused"
+
+const (
+	DefaultIdleHours = 24
+	MinIdleHours     = 1
+	MaxIdleHours     = 24 * 14
+
+	// IdleMetricsMaxAge 이보다 오래된 메트릭은 판단에 쓰지 않음 (오퍼레이터 보고 중단 등)
+	IdleMetricsMaxAge = 15 * time.Minute
+
+	// IdleSampleWindow 초당 작업 수를 구하려고 작업 카운터를 두 번 읽는 간격
+	IdleSampleWindow = 5 * time.Second
+)
+
+// ActivitySampler 유휴 판단용 사용량 조회를 지원하는 엔진이 구현
+// MongoDB: $currentOp + opcounters, Redis: CLIENT LIST + INFO commandstats
+type ActivitySampler interface {
+	// ActivityCommand window 동안의 사용량을 출력하는 클라이언트 명령 (ClientImage 컨테이너에서 실행)
+	// 접속 정보는 DB_HOST, DB_PORT 와 인스턴스 Secret 환경 변수로 전달됨
+	ActivityCommand(window time.Duration) []string
+
+	// ParseActivity 사용자 연결 수와 초당 작업 수
+	// 조회에 쓴 연결, 헬스 체크, 레플리카 간 연결과 관리 명령은 제외
+	ParseActivity(output []byte, window time.Duration) (connections int, opsPerSecond int, err error)
+}
+
+// IdlePolicy 연결 수와 초당 작업 수가 IdleHours 동안 0 이면 자동 중지
+type IdlePolicy struct {
+	Enabled   bool `json:"enabled"`
+	IdleHours int  `json:"idleHours"`
+}
+
+// IsIdle 메트릭 기준 유휴 여부, 판단할 수 없으면 ok=false
+func (m *InstanceMetrics) IsIdle(now time.Time) (idle bool, ok bool) {
+	if m == nil || m.Timestamp.IsZero() || now.Sub(m.Timestamp) > IdleMetricsMaxAge {
+		return false, false
+	}
+	return m.Connections == 0 && m.OperationsPerSecond == 0, true
+}
+
+// IsIdlePaused 유휴 자동 중지 상태, 원클릭 재개 대상
+func (d *DBInstance) IsIdlePaused() bool {
+	return d.Status == StatusStopped && d.StatusReason == IdlePausedReason
+}
+
+type UpdateIdlePolicyRequest struct {
+	Enabled   bool `json:"enabled"`
+	IdleHours int  `json:"idleHours,omitempty"`
+}
+
+func (r *UpdateIdlePolicyRequest) Validate() error {
+	if r.IdleHours == 0 {
+		r.IdleHours = DefaultIdleHours
+	}
+	if r.IdleHours < MinIdleHours || r.IdleHours > MaxIdleHours {
+		return errors.NewInvalidParameterError("idleHours",
+			fmt.Sprintf("%d~%d 사이여야 합니다", MinIdleHours, MaxIdleHours))
+	}
+	return nil
+}
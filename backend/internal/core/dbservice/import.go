@@ -0,0 +1,56 @@
+package dbservice
+
+import (
+	"time"
+)
+
+// DataImport 업로드 파일로 인스턴스에 데이터를 적재한 이력
+// 파일은 백엔드에 임시 보관되고, 실제 적재는 오퍼레이터 import Job 이 수행
+type DataImport struct {
+	ID           string       `json:"id"` // external_id
+	InstanceID   int64        `json:"-"`
+	Format       ImportFormat `json:"format"`
+	Database     string       `json:"database,omitempty"`
+	Collection   string       `json:"collection,omitempty"`
+	Drop         bool         `json:"drop"`
+	Status       ImportStatus `json:"status"`
+	SizeBytes    int64        `json:"sizeBytes"`
+	ErrorMessage string       `json:"errorMessage,omitempty"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	CompletedAt  *time.Time   `json:"completedAt,omitempty"`
+
+	// SampleDataset 내장 샘플 데이터셋 적재인 경우 데이터셋 ID
+	SampleDataset string `json:"sampleDataset,omitempty"`
+
+	// StagingToken import Job 이 파일을 내려받을 때 쓰는 일회용 토큰
+	StagingToken string `json:"-"`
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatMongodump ImportFormat = "mongodump" // mongodump --archive (gzip 가능)
+	ImportFormatJSON      ImportFormat = "json"      // JSON 배열 또는 한 줄에 문서 하나
+	ImportFormatCSV       ImportFormat = "csv"       // 첫 줄이 헤더
+	ImportFormatRESP      ImportFormat = "resp"      // redis-cli --pipe 형식
+	ImportFormatRDB       ImportFormat = "rdb"       // 검증 시 안내용, 적재는 지원하지 않음
+)
+
+type ImportStatus string
+
+const (
+	ImportStatusPending   ImportStatus = "pending" // 오퍼레이터 처리 대기
+	ImportStatusRunning   ImportStatus = "running"
+	ImportStatusCompleted ImportStatus = "completed"
+	ImportStatusFailed    ImportStatus = "failed"
+)
+
+func (s ImportStatus) Finished() bool {
+	return s == ImportStatusCompleted || s == ImportStatusFailed
+}
+
+// Importer 업로드 파일 적재를 지원하는 엔진
+type Importer interface {
+	// ValidateImport 엔진이 받을 수 있는 형식과 대상인지 확인
+	ValidateImport(req *StartImportRequest) error
+}
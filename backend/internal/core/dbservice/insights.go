@@ -0,0 +1,117 @@
+package dbservice
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// ProfilingConfig 느린 작업 수집 설정
+// MongoDB: operationProfiling slowOp 모드, Redis: slowlog-log-slower-than
+type ProfilingConfig struct {
+	Enabled         bool `json:"enabled"`
+	SlowThresholdMs int  `json:"slowThresholdMs"`
+}
+
+// SlowOperation 엔진에서 수집한 느린 작업 하나
+type SlowOperation struct {
+	Time      time.Time
+	Namespace string // MongoDB: db.collection
+	Shape     string // 값을 ? 로 바꾼 쿼리 형태
+	Duration  time.Duration
+
+	// IndexSuggestion 컬렉션 스캔일 때 추천 인덱스 (MongoDB)
+	IndexSuggestion string
+}
+
+// Profiler 느린 작업 수집을 지원하는 엔진이 구현
+// MongoDB: system.profile, Redis: SLOWLOG GET
+type Profiler interface {
+	// SlowLogCommand 느린 작업을 출력하는 클라이언트 명령 (ClientImage 컨테이너에서 실행)
+	// 접속 정보는 DB_HOST, DB_PORT 와 인스턴스 Secret 환경 변수로 전달됨
+	SlowLogCommand(limit int) []string
+
+	// ParseSlowLog SlowLogCommand 출력에서 느린 작업 추출, 해석할 수 없는 줄은 건너뜀
+	ParseSlowLog(output []byte) ([]*SlowOperation, error)
+}
+
+// QueryShapeStats 같은 형태의 느린 작업 통계
+type QueryShapeStats struct {
+	Shape           string    `json:"shape"`
+	Namespace       string    `json:"namespace,omitempty"`
+	Count           int       `json:"count"`
+	TotalMs         float64   `json:"totalMs"`
+	P50Ms           float64   `json:"p50Ms"`
+	P95Ms           float64   `json:"p95Ms"`
+	P99Ms           float64   `json:"p99Ms"`
+	MaxMs           float64   `json:"maxMs"`
+	LastSeen        time.Time `json:"lastSeen"`
+	IndexSuggestion string    `json:"indexSuggestion,omitempty"`
+}
+
+// SummarizeSlowOperations 형태별로 묶어 총 소요 시간이 큰 순서로 상위 limit 개 반환
+func SummarizeSlowOperations(ops []*SlowOperation, since time.Time, limit int) []*QueryShapeStats {
+	type group struct {
+		stats     *QueryShapeStats
+		durations []float64
+	}
+
+	groups := make(map[string]*group)
+	for _, op := range ops {
+		if op.Time.Before(since) {
+			continue
+		}
+
+		key := op.Namespace + "\x00" + op.Shape
+		g, ok := groups[key]
+		if !ok {
+			g = &group{stats: &QueryShapeStats{Shape: op.Shape, Namespace: op.Namespace}}
+			groups[key] = g
+		}
+
+		ms := float64(op.Duration) / float64(time.Millisecond)
+		g.durations = append(g.durations, ms)
+		g.stats.Count++
+		g.stats.TotalMs += ms
+		if op.Time.After(g.stats.LastSeen) {
+			g.stats.LastSeen = op.Time
+		}
+		if op.IndexSuggestion != "" {
+			g.stats.IndexSuggestion = op.IndexSuggestion
+		}
+	}
+
+	result := make([]*QueryShapeStats, 0, len(groups))
+	for _, g := range groups {
+		sort.Float64s(g.durations)
+		g.stats.P50Ms = percentile(g.durations, 50)
+		g.stats.P95Ms = percentile(g.durations, 95)
+		g.stats.P99Ms = percentile(g.durations, 99)
+		g.stats.MaxMs = g.durations[len(g.durations)-1]
+		result = append(result, g.stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalMs != result[j].TotalMs {
+			return result[i].TotalMs > result[j].TotalMs
+		}
+		return result[i].Shape < result[j].Shape
+	})
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// percentile nearest-rank 방식, sorted 는 오름차순
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
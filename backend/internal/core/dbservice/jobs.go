@@ -0,0 +1,22 @@
+package dbservice
+
+import "github.com/piper-hyowon/dBtree/internal/core/job"
+
+// 인스턴스 작업 종류, 요청은 검증 후 작업을 등록하고 202 + 작업 ID 반환
+const (
+	// JobProvisionInstance 생성 사가의 K8s 단계 실행
+	JobProvisionInstance job.Type = "instance.provision"
+	// JobDeleteInstance 휴지통 이동은 StatefulSet 축소, 영구 삭제는 리소스 정리
+	JobDeleteInstance  job.Type = "instance.delete"
+	JobRestartInstance job.Type = "instance.restart"
+)
+
+// ProvisionJobPayload 생성 작업이 진행할 사가
+type ProvisionJobPayload struct {
+	SagaID int64 `json:"sagaId"`
+}
+
+// DeleteJobPayload Permanent 가 아니면 휴지통 이동
+type DeleteJobPayload struct {
+	Permanent bool `json:"permanent"`
+}
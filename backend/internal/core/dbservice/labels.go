@@ -0,0 +1,209 @@
+package dbservice
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/common"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+const (
+	// MaxInstanceLabels 인스턴스당 라벨 최대 개수
+	MaxInstanceLabels = 20
+	// MaxLabelLength K8s 라벨 이름/값 길이 제한
+	MaxLabelLength          = 63
+	MaxDescriptionLength    = 500
+	DefaultInstancePageSize = 20
+)
+
+// K8s 라벨 이름/값 형식, CRD 라벨로 그대로 옮기므로 같은 규칙 사용
+var labelPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.-]*[A-Za-z0-9])?$`)
+
+// ValidateLabels key=value 라벨 검증, 값은 비어 있어도 됨
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxInstanceLabels {
+		return errors.NewInvalidParameterError("labels",
+			fmt.Sprintf("최대 %d개까지 지정할 수 있습니다", MaxInstanceLabels))
+	}
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+		if err := validateLabelValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	if len(key) > MaxLabelLength || !labelPattern.MatchString(key) {
+		return errors.NewInvalidParameterError("labels",
+			fmt.Sprintf("라벨 이름은 영문/숫자로 시작하고 끝나는 %d자 이하의 영문, 숫자, '-', '_', '.' 만 사용할 수 있습니다: %s", MaxLabelLength, key))
+	}
+	return nil
+}
+
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > MaxLabelLength || !labelPattern.MatchString(value) {
+		return errors.NewInvalidParameterError("labels",
+			fmt.Sprintf("라벨 값은 영문/숫자로 시작하고 끝나는 %d자 이하의 영문, 숫자, '-', '_', '.' 만 사용할 수 있습니다: %s", MaxLabelLength, value))
+	}
+	return nil
+}
+
+// LabelOperator K8s equality-based 셀렉터와 같은 연산
+type LabelOperator string
+
+const (
+	LabelEquals       LabelOperator = "="
+	LabelNotEquals    LabelOperator = "!="
+	LabelExists       LabelOperator = "exists"
+	LabelDoesNotExist LabelOperator = "!exists"
+)
+
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Value    string
+}
+
+// ParseLabelSelector "env=prod,tier!=cache,team,!legacy" 형식, 조건은 모두 AND
+func ParseLabelSelector(selector string) ([]LabelRequirement, error) {
+	var requirements []LabelRequirement
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var req LabelRequirement
+		switch {
+		case strings.HasPrefix(term, "!"):
+			req = LabelRequirement{Key: strings.TrimSpace(term[1:]), Operator: LabelDoesNotExist}
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			req = LabelRequirement{Key: strings.TrimSpace(parts[0]), Operator: LabelNotEquals, Value: strings.TrimSpace(parts[1])}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(strings.Replace(term, "==", "=", 1), "=", 2)
+			req = LabelRequirement{Key: strings.TrimSpace(parts[0]), Operator: LabelEquals, Value: strings.TrimSpace(parts[1])}
+		default:
+			req = LabelRequirement{Key: term, Operator: LabelExists}
+		}
+
+		if err := validateLabelKey(req.Key); err != nil {
+			return nil, err
+		}
+		if err := validateLabelValue(req.Value); err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
+
+// InstanceSortField GET /db/instances ?sort=
+type InstanceSortField string
+
+const (
+	SortByCreatedAt InstanceSortField = "createdAt"
+	SortByUpdatedAt InstanceSortField = "updatedAt"
+	SortByName      InstanceSortField = "name"
+	SortByStatus    InstanceSortField = "status"
+	SortByType      InstanceSortField = "type"
+	SortByCost      InstanceSortField = "cost" // 시간당 비용
+)
+
+var validSortFields = map[InstanceSortField]bool{
+	SortByCreatedAt: true,
+	SortByUpdatedAt: true,
+	SortByName:      true,
+	SortByStatus:    true,
+	SortByType:      true,
+	SortByCost:      true,
+}
+
+// ListInstancesRequest GET /db/instances 쿼리 파라미터
+type ListInstancesRequest struct {
+	common.PaginationParams
+	Deleted  bool // 휴지통 목록
+	Selector []LabelRequirement
+	Statuses []InstanceStatus
+	Types    []DBType
+	Sort     InstanceSortField
+	Order    string // asc, desc, 비어 있으면 정렬 기준의 기본 방향
+	Desc     bool
+}
+
+func (r *ListInstancesRequest) SetDefaults() {
+	r.PaginationParams.SetDefaults(DefaultInstancePageSize)
+	if r.Sort == "" {
+		r.Sort = SortByCreatedAt
+		// 정렬 기준과 방향을 모두 생략하면 최신순
+		if r.Order == "" {
+			r.Order = "desc"
+		}
+	}
+	r.Desc = strings.EqualFold(r.Order, "desc")
+}
+
+func (r *ListInstancesRequest) Validate() error {
+	r.SetDefaults()
+	if r.Limit > 100 {
+		return errors.NewInvalidParameterError("limit", "최대 100 이하여야 합니다")
+	}
+	if !validSortFields[r.Sort] {
+		return errors.NewInvalidParameterError("sort", fmt.Sprintf("지원하지 않는 정렬 기준: %s", r.Sort))
+	}
+	if r.Order != "" && !strings.EqualFold(r.Order, "asc") && !strings.EqualFold(r.Order, "desc") {
+		return errors.NewInvalidParameterError("order", fmt.Sprintf("asc 또는 desc 여야 합니다: %s", r.Order))
+	}
+	for _, status := range r.Statuses {
+		switch status {
+		case StatusProvisioning, StatusRunning, StatusStopped, StatusPaused, StatusError, StatusDeleting,
+			StatusMaintenance, StatusBackingUp, StatusRestoring, StatusUpgrading:
+		default:
+			return errors.NewInvalidParameterError("status", fmt.Sprintf("알 수 없는 상태: %s", status))
+		}
+	}
+	for _, t := range r.Types {
+		if _, ok := LookupEngine(t); !ok {
+			return errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 데이터베이스 타입: %s", t))
+		}
+	}
+	return nil
+}
+
+type InstanceListResponse struct {
+	Data       []InstanceResponse     `json:"data"`
+	Pagination *common.PaginationInfo `json:"pagination"`
+}
+
+// UpdateMetadataRequest 라벨은 통째로 교체, Description 은 지정한 경우만 변경
+type UpdateMetadataRequest struct {
+	Labels      map[string]string `json:"labels"`
+	Description *string           `json:"description,omitempty"`
+}
+
+func (r *UpdateMetadataRequest) Validate() error {
+	if r.Labels == nil && r.Description == nil {
+		return errors.NewInvalidParameterError("request", "변경할 항목을 하나 이상 지정해야 합니다")
+	}
+	if err := ValidateLabels(r.Labels); err != nil {
+		return err
+	}
+	if r.Description != nil {
+		description := strings.TrimSpace(*r.Description)
+		if len([]rune(description)) > MaxDescriptionLength {
+			return errors.NewInvalidParameterError("description",
+				fmt.Sprintf("최대 %d자 이하여야 합니다", MaxDescriptionLength))
+		}
+		r.Description = &description
+	}
+	return nil
+}
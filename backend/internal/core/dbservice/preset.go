@@ -0,0 +1,61 @@
+package dbservice
+
+import (
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// PresetVisibility 시스템 프리셋은 모두에게, 사용자 프리셋은 심사를 거쳐 공개되기 전까지 본인에게만 보임
+type PresetVisibility string
+
+const (
+	PresetSystem   PresetVisibility = "system"
+	PresetPrivate  PresetVisibility = "private"
+	PresetPending  PresetVisibility = "pending" // 공개 신청, 심사 대기
+	PresetPublic   PresetVisibility = "public"  // 갤러리 공개
+	PresetRejected PresetVisibility = "rejected"
+)
+
+const (
+	// MaxPresetsPerUser 사용자당 저장 가능한 프리셋 개수
+	MaxPresetsPerUser = 10
+
+	// UserPresetSortOrder 목록에서 시스템 프리셋 뒤에 표시
+	UserPresetSortOrder = 1000
+)
+
+func (p *DBPreset) IsUserPreset() bool {
+	return p.OwnerID != ""
+}
+
+// VisibleTo 시스템/공개 프리셋이거나 본인 프리셋
+func (p *DBPreset) VisibleTo(userID string) bool {
+	if !p.IsUserPreset() || p.Visibility == PresetPublic {
+		return true
+	}
+	return p.OwnerID == userID
+}
+
+// CreatePresetRequest 본인 인스턴스의 현재 스펙을 프리셋으로 저장
+type CreatePresetRequest struct {
+	InstanceID  string `json:"instanceId" validate:"required"`
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description,omitempty" validate:"max=500"`
+	Icon        string `json:"icon,omitempty" validate:"max=50"`
+}
+
+func (r *CreatePresetRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Description = strings.TrimSpace(r.Description)
+	if r.Name == "" {
+		return errors.NewMissingParameterError("name")
+	}
+	return nil
+}
+
+// ModeratePresetRequest 공개 신청 심사, 거절 시 사유는 작성자에게 표시
+type ModeratePresetRequest struct {
+	Approve bool   `json:"approve"`
+	Note    string `json:"note,omitempty" validate:"max=500"`
+}
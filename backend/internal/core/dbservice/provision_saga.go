@@ -0,0 +1,82 @@
+package dbservice
+
+import (
+	"time"
+)
+
+// ProvisionSagaLease 요청이나 워커가 사가를 처리하는 동안 다른 워커가 가져가지 않는 시간
+// 단계를 기록할 때마다 연장되고, 처리하던 프로세스가 죽으면 만료 후 워커가 이어서 처리
+const ProvisionSagaLease = 5 * time.Minute
+
+// ProvisionSagaMaxAttempts 워커가 진행을 재시도하는 최대 횟수, 넘으면 보상(롤백)으로 전환
+const ProvisionSagaMaxAttempts = 5
+
+type ProvisionSagaStatus string
+
+const (
+	SagaRunning      ProvisionSagaStatus = "running"
+	SagaCompleted    ProvisionSagaStatus = "completed"
+	SagaCompensating ProvisionSagaStatus = "compensating"
+	SagaCompensated  ProvisionSagaStatus = "compensated"
+	// SagaFailed 보상 중 일부(환불)가 실패, lemon_refund_failures 에서 재시도
+	SagaFailed ProvisionSagaStatus = "failed"
+)
+
+// Finished 워커가 더 처리할 것이 없는 상태
+func (s ProvisionSagaStatus) Finished() bool {
+	return s == SagaCompleted || s == SagaCompensated || s == SagaFailed
+}
+
+// ProvisionStep 인스턴스 생성 단계, ProvisionSteps 순서로 실행하고 역순으로 보상
+type ProvisionStep string
+
+const (
+	StepDeductLemons    ProvisionStep = "deduct_lemons"
+	StepAllocatePort    ProvisionStep = "allocate_port"
+	StepStageSampleData ProvisionStep = "stage_sample_data"
+	StepProvisionK8s    ProvisionStep = "provision_k8s"
+)
+
+var ProvisionSteps = []ProvisionStep{
+	StepDeductLemons,
+	StepAllocatePort,
+	StepStageSampleData,
+	StepProvisionK8s,
+}
+
+type ProvisionStepStatus string
+
+const (
+	// StepStarted 실행을 시작했지만 결과를 기록하지 못함, 재개 시 실제 반영 여부를 확인
+	StepStarted     ProvisionStepStatus = "started"
+	StepCompleted   ProvisionStepStatus = "completed"
+	StepCompensated ProvisionStepStatus = "compensated"
+	// StepFailed 보상 실패
+	StepFailed ProvisionStepStatus = "failed"
+)
+
+// ProvisionSagaOptions 재개할 때 필요한 생성 요청 정보
+type ProvisionSagaOptions struct {
+	SampleDataset string `json:"sampleDataset,omitempty"`
+	DataImportID  string `json:"dataImportId,omitempty"` // 샘플 데이터셋 준비 후 기록
+	CloneInstance string `json:"cloneInstance,omitempty"`
+	CloneArchive  string `json:"cloneArchive,omitempty"`
+}
+
+// ProvisionSaga 인스턴스 생성 사가, db_instances 와 같은 트랜잭션에서 만들어짐
+type ProvisionSaga struct {
+	ID         int64
+	InstanceID int64
+	UserID     string
+	Status     ProvisionSagaStatus
+	Options    ProvisionSagaOptions
+	Steps      map[ProvisionStep]ProvisionStepStatus
+	LastError  string
+	Attempts   int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (s *ProvisionSaga) StepStatus(step ProvisionStep) ProvisionStepStatus {
+	return s.Steps[step]
+}
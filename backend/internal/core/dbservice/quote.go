@@ -0,0 +1,76 @@
+package dbservice
+
+import (
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/resource"
+)
+
+// QuoteIssue 생성 요청을 보냈다면 거절됐을 사유, 에러 응답(rest.ErrorResponse)과 같은 code/data
+type QuoteIssue struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// NewQuoteIssue 도메인 에러만 견적 문제로 변환, 내부 오류 등은 ok=false
+func NewQuoteIssue(err error) (QuoteIssue, bool) {
+	var domainErr errors.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() == errors.ErrInternalServer {
+		return QuoteIssue{}, false
+	}
+	return QuoteIssue{
+		Code:    int(domainErr.Code()),
+		Message: "[" + domainErr.Code().String() + "] " + domainErr.Error(),
+		Data:    domainErr.ErrorData(),
+	}, true
+}
+
+// CapacityVerdict 노드 여유 리소스로 할당 가능한지 (resourceManager.CanAllocate 결과)
+type CapacityVerdict struct {
+	CanAllocate bool                        `json:"canAllocate"`
+	Reason      string                      `json:"reason,omitempty"`
+	Requested   resource.SystemResourceSpec `json:"requested"`
+}
+
+// BalanceCoverage 현재 레몬 잔액으로 생성 비용을 내고 몇 시간 운영할 수 있는지
+type BalanceCoverage struct {
+	Lemons          int  `json:"lemons"`
+	CanAffordCreate bool `json:"canAffordCreate"`
+	CoveredHours    int  `json:"coveredHours"` // 생성 비용 차감 후 잔액 기준
+}
+
+func NewBalanceCoverage(lemons int, cost LemonCost) BalanceCoverage {
+	coverage := BalanceCoverage{
+		Lemons:          lemons,
+		CanAffordCreate: lemons >= cost.CreationCost,
+	}
+	if coverage.CanAffordCreate && cost.HourlyLemons > 0 {
+		coverage.CoveredHours = (lemons - cost.CreationCost) / cost.HourlyLemons
+	}
+	return coverage
+}
+
+// InstanceQuote 생성 전 견적, 스펙을 결정하지 못하면 Errors 만 채워짐
+type InstanceQuote struct {
+	Valid     bool                   `json:"valid"` // 지금 생성 요청을 보내면 통과하는지
+	Type      DBType                 `json:"type,omitempty"`
+	Mode      DBMode                 `json:"mode,omitempty"`
+	Size      DBSize                 `json:"size,omitempty"`
+	Resources *ResourceSpec          `json:"resources,omitempty"`
+	Config    map[string]interface{} `json:"config,omitempty"` // 기본값 적용 후
+	Cost      *CostResponse          `json:"cost,omitempty"`
+	Capacity  *CapacityVerdict       `json:"capacity,omitempty"`
+	Balance   *BalanceCoverage       `json:"balance,omitempty"`
+	Errors    []QuoteIssue           `json:"errors"`
+}
+
+// AddError 도메인 에러는 견적 문제로 기록, 그 외 에러는 그대로 반환
+func (q *InstanceQuote) AddError(err error) error {
+	issue, ok := NewQuoteIssue(err)
+	if !ok {
+		return err
+	}
+	q.Errors = append(q.Errors, issue)
+	q.Valid = false
+	return nil
+}
@@ -0,0 +1,12 @@
+package dbservice
+
+import "time"
+
+// RecycleBinReason 휴지통에 있는 인스턴스의 status_reason
+const RecycleBinReason = "deleted"
+
+// DefaultDeleteRetention 삭제 후 PVC 를 보관하는 기본 기간, 이 안에서만 복구 가능
+const DefaultDeleteRetention = 24 * time.Hour
+
+// PurgeBatch 리퍼가 한 번에 정리하는 최대 인스턴스 수
+const PurgeBatch = 20
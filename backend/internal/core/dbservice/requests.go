@@ -1,14 +1,22 @@
 package dbservice
 
 import (
-	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"fmt"
+	"net"
+	"strings"
 	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
 )
 
 type CreateInstanceRequest struct {
 	Name     string  `json:"name" validate:"required,min=3,max=63,instancename"`
 	PresetID *string `json:"presetId,omitempty"`
 
+	// 메타데이터, 라벨은 DBInstance CRD 라벨에도 반영
+	Labels      map[string]string `json:"labels,omitempty"`
+	Description string            `json:"description,omitempty" validate:"max=500"`
+
 	// 커스텀 옵션 (PresetID 없을 때만)
 	Type      *DBType                `json:"type,omitempty"`
 	Mode      *DBMode                `json:"mode,omitempty"`
@@ -19,6 +27,19 @@ type CreateInstanceRequest struct {
 	BackupEnabled       bool   `json:"backupEnabled"`
 	BackupSchedule      string `json:"backupSchedule,omitempty" validate:"omitempty,cronschedule"`
 	BackupRetentionDays int    `json:"backupRetentionDays,omitempty" validate:"min=0,max=365"`
+
+	// 샘플 데이터셋, nil 이면 프리셋 기본값, 빈 문자열이면 적재하지 않음
+	SampleDataset *string `json:"sampleDataset,omitempty"`
+
+	// 복제 옵션 (둘 중 하나만), 원본과 같은 타입/모드로 생성하고 데이터를 채운 뒤 running 전환
+	// Resources 를 지정하지 않으면 원본 리소스를 그대로 사용
+	SourceInstanceID *string `json:"sourceInstanceId,omitempty"` // 실행 중인 인스턴스의 현재 데이터
+	SourceBackupID   *string `json:"sourceBackupId,omitempty"`   // 완료된 백업
+}
+
+// IsClone 다른 인스턴스나 백업을 복제하는 생성 요청
+func (r *CreateInstanceRequest) IsClone() bool {
+	return r.SourceInstanceID != nil || r.SourceBackupID != nil
 }
 
 type CreateInstanceResponse struct {
@@ -30,6 +51,7 @@ type CreateInstanceResponse struct {
 	Cost        LemonCost    `json:"cost"`
 	CreatedAt   time.Time    `json:"createdAt"`
 	Credentials *Credentials `json:"credentials,omitempty"` // 생성시에만 포함
+	JobID       string       `json:"jobId,omitempty"`       // 프로비저닝 작업, GET /jobs/:id 로 진행 상황 조회
 }
 
 type Credentials struct {
@@ -40,14 +62,59 @@ type Credentials struct {
 	ExternalURI  string `json:"externalUri,omitempty"`
 }
 
+type RotateCredentialsResponse struct {
+	Rotation    *CredentialRotation `json:"rotation"`
+	Credentials *Credentials        `json:"credentials"` // 교체 응답에서만 제공
+}
+
+const (
+	DefaultLogTailLines = 200
+	MaxLogTailLines     = 5000
+)
+
+// InstanceLogsRequest 로그 조회 조건 (쿼리 파라미터)
+type InstanceLogsRequest struct {
+	Pod      string     // 비어 있으면 첫 번째 멤버
+	Follow   bool       // 연결이 끊길 때까지 새 로그 전송
+	Since    *time.Time // 이 시각 이후 로그만
+	Tail     int        // 마지막 N 줄
+	Previous bool       // 재시작 전 컨테이너 로그
+}
+
+func (r *InstanceLogsRequest) Validate() error {
+	if r.Tail <= 0 {
+		r.Tail = DefaultLogTailLines
+	}
+	if r.Tail > MaxLogTailLines {
+		return errors.NewInvalidParameterError("tail",
+			fmt.Sprintf("최대 %d줄까지 조회할 수 있습니다", MaxLogTailLines))
+	}
+	if r.Since != nil && r.Since.After(time.Now()) {
+		return errors.NewInvalidParameterError("since", "미래 시각은 지정할 수 없습니다")
+	}
+	if r.Follow && r.Previous {
+		return errors.NewInvalidParameterError("previous", "follow 와 함께 사용할 수 없습니다")
+	}
+	return nil
+}
+
+// UpdateInstanceRequest 지정한 필드만 변경, Config 는 기존 설정에 덮어씀
 type UpdateInstanceRequest struct {
-	Resources *ResourceSpec          `json:"resources,omitempty"`
+	Resources *ResourceSpec          `json:"resources,omitempty" validate:"omitempty"`
 	Config    map[string]interface{} `json:"config,omitempty"`
 
 	// 백업 설정 변경
 	BackupEnabled       *bool   `json:"backupEnabled,omitempty"`
-	BackupSchedule      *string `json:"backupSchedule,omitempty"`
-	BackupRetentionDays *int    `json:"backupRetentionDays,omitempty"`
+	BackupSchedule      *string `json:"backupSchedule,omitempty" validate:"omitempty,cronschedule"`
+	BackupRetentionDays *int    `json:"backupRetentionDays,omitempty" validate:"omitempty,min=1,max=365"`
+}
+
+func (r *UpdateInstanceRequest) Validate() error {
+	if r.Resources == nil && len(r.Config) == 0 &&
+		r.BackupEnabled == nil && r.BackupSchedule == nil && r.BackupRetentionDays == nil {
+		return errors.NewInvalidParameterError("request", "변경할 항목을 하나 이상 지정해야 합니다")
+	}
+	return nil
 }
 
 type InstanceResponse struct {
@@ -70,7 +137,16 @@ type InstanceResponse struct {
 	CreatedAt           time.Time              `json:"createdAt"`
 	UpdatedAt           time.Time              `json:"updatedAt"`
 	CreatedFromPreset   *string                `json:"createdFromPreset,omitempty"`
+	Labels              map[string]string      `json:"labels,omitempty"`
+	Description         string                 `json:"description,omitempty"`
 	PausedAt            *time.Time             `json:"pausedAt,omitempty"`
+	Conditions          []K8sCondition         `json:"conditions,omitempty"`
+	Metrics             *InstanceMetrics       `json:"metrics,omitempty"`
+	DeletedAt           *time.Time             `json:"deletedAt,omitempty"`
+	PurgeAfter          *time.Time             `json:"purgeAfter,omitempty"`
+	IdlePolicy          *IdlePolicy            `json:"idlePolicy,omitempty"`
+	IdleSince           *time.Time             `json:"idleSince,omitempty"`
+	Schedule            *PowerScheduleResponse `json:"schedule,omitempty"`
 }
 
 type CostResponse struct {
@@ -94,9 +170,13 @@ type PresetResponse struct {
 	Resources           ResourceSpec           `json:"resources"`
 	Cost                CostResponse           `json:"cost"`
 	DefaultConfig       map[string]interface{} `json:"defaultConfig,omitempty"`
+	SampleDataset       string                 `json:"sampleDataset,omitempty"`
 	SortOrder           int                    `json:"sortOrder"`
 	Available           bool                   `json:"available"`
 	UnavailableReason   string                 `json:"unavailableReason,omitempty"`
+	Visibility          PresetVisibility       `json:"visibility"`
+	ModerationNote      string                 `json:"moderationNote,omitempty"`
+	Backup              *BackupConfig          `json:"backup,omitempty"`
 }
 
 // 예상 비용
@@ -120,46 +200,26 @@ func (r *CreateInstanceRequest) Validate() error {
 			"PresetID와 커스텀 옵션(type, resources)은 동시에 사용할 수 없습니다")
 	}
 
-	// PresetID 없으면 type과 resources 필수
-	if r.PresetID == nil && (r.Type == nil || r.Resources == nil) {
+	// PresetID 없으면 type과 resources 필수 (복제는 원본 스펙 사용)
+	if !r.IsClone() && r.PresetID == nil && (r.Type == nil || r.Resources == nil) {
 		return errors.NewInvalidParameterError("request",
 			"PresetID가 없으면 type과 resources를 반드시 지정해야 합니다")
 	}
 
 	// DBType 유효성 검증
 	if r.Type != nil {
-		// 현재는 MongoDB만 지원
-		if *r.Type == Redis {
-			return errors.NewInvalidParameterError("type", "Redis는 아직 지원하지 않습니다")
-		}
-		if *r.Type != MongoDB {
+		engine, ok := LookupEngine(*r.Type)
+		if !ok {
 			return errors.NewInvalidParameterError("type", "지원하지 않는 데이터베이스 타입입니다")
 		}
-	}
+		if err := engine.Available(); err != nil {
+			return err
+		}
 
-	// DBMode 유효성 검증 - Type에 따라 다른 모드 허용
-	if r.Mode != nil && r.Type != nil {
-		switch *r.Type {
-		case MongoDB:
-			validModes := map[DBMode]bool{
-				ModeStandalone: true,
-				ModeReplicaSet: true,
-				ModeSharded:    true,
-			}
-			if !validModes[*r.Mode] {
-				return errors.NewInvalidParameterError("mode",
-					"MongoDB는 standalone, replica_set, sharded 모드만 지원합니다")
-			}
-		case Redis:
-			validModes := map[DBMode]bool{
-				ModeBasic:    true,
-				ModeSentinel: true,
-				ModeCluster:  true,
-			}
-			if !validModes[*r.Mode] {
-				return errors.NewInvalidParameterError("mode",
-					"Redis는 basic, sentinel, cluster 모드만 지원합니다")
-			}
+		// DBMode 유효성 검증 - Type에 따라 다른 모드 허용
+		if r.Mode != nil && !SupportsMode(engine, *r.Mode) {
+			return errors.NewInvalidParameterError("mode",
+				fmt.Sprintf("%s는 %s 모드만 지원합니다", *r.Type, joinModes(engine.Modes())))
 		}
 	}
 
@@ -169,6 +229,11 @@ func (r *CreateInstanceRequest) Validate() error {
 		r.Mode = &defaultMode
 	}
 
+	if err := ValidateLabels(r.Labels); err != nil {
+		return err
+	}
+	r.Description = strings.TrimSpace(r.Description)
+
 	// 백업 설정 검증
 	if r.BackupEnabled {
 		// 백업이 활성화되면 스케줄 필수
@@ -185,3 +250,182 @@ func (r *CreateInstanceRequest) Validate() error {
 
 	return nil
 }
+
+// MaxAllowedCIDRs 인스턴스당 허용 CIDR 최대 개수 (CRD MaxItems 와 동일)
+const MaxAllowedCIDRs = 20
+
+type UpdateAllowlistRequest struct {
+	CIDRs []string `json:"cidrs"`
+}
+
+// Validate CIDR 형식 확인 후 정규화, 단일 IP 는 /32(/128)로 변환하고 중복 제거
+func (r *UpdateAllowlistRequest) Validate() error {
+	if len(r.CIDRs) > MaxAllowedCIDRs {
+		return errors.NewInvalidParameterError("cidrs",
+			fmt.Sprintf("최대 %d개까지 등록할 수 있습니다", MaxAllowedCIDRs))
+	}
+
+	normalized := make([]string, 0, len(r.CIDRs))
+	seen := make(map[string]bool, len(r.CIDRs))
+	for _, raw := range r.CIDRs {
+		cidr, err := normalizeCIDR(strings.TrimSpace(raw))
+		if err != nil {
+			return errors.NewInvalidParameterError("cidrs",
+				fmt.Sprintf("올바른 IP 또는 CIDR 형식이 아닙니다: %s", raw))
+		}
+		if !seen[cidr] {
+			seen[cidr] = true
+			normalized = append(normalized, cidr)
+		}
+	}
+
+	r.CIDRs = normalized
+	return nil
+}
+
+func normalizeCIDR(value string) (string, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return "", fmt.Errorf("invalid ip %q", value)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", err
+	}
+	return network.String(), nil
+}
+
+type AllowlistResponse struct {
+	CIDRs        []string   `json:"cidrs"`
+	AppliedCIDRs []string   `json:"appliedCidrs"`
+	AppliedAt    *time.Time `json:"appliedAt,omitempty"`
+	Pending      bool       `json:"pending"` // 오퍼레이터 적용 대기 중
+}
+
+const (
+	DefaultSlowThresholdMs = 100
+	MaxSlowThresholdMs     = 60000 // CRD Maximum 과 동일
+
+	DefaultTopQueries = 10
+	MaxTopQueries     = 50
+
+	DefaultInsightsWindow = 24 * time.Hour
+	MaxInsightsWindow     = 7 * 24 * time.Hour
+)
+
+type UpdateProfilingRequest struct {
+	Enabled         bool `json:"enabled"`
+	SlowThresholdMs int  `json:"slowThresholdMs,omitempty"`
+}
+
+func (r *UpdateProfilingRequest) Validate() error {
+	if r.SlowThresholdMs == 0 {
+		r.SlowThresholdMs = DefaultSlowThresholdMs
+	}
+	if r.SlowThresholdMs < 1 || r.SlowThresholdMs > MaxSlowThresholdMs {
+		return errors.NewInvalidParameterError("slowThresholdMs",
+			fmt.Sprintf("1~%d 사이여야 합니다", MaxSlowThresholdMs))
+	}
+	return nil
+}
+
+type SlowQueryRequest struct {
+	Window time.Duration
+	Limit  int
+}
+
+// Validate 기본값 적용, 범위를 벗어난 값은 최대값으로 제한
+func (r *SlowQueryRequest) Validate() error {
+	if r.Window < 0 {
+		return errors.NewInvalidParameterError("window", "음수일 수 없습니다")
+	}
+	if r.Limit < 0 {
+		return errors.NewInvalidParameterError("limit", "음수일 수 없습니다")
+	}
+	if r.Window == 0 {
+		r.Window = DefaultInsightsWindow
+	}
+	if r.Window > MaxInsightsWindow {
+		r.Window = MaxInsightsWindow
+	}
+	if r.Limit == 0 {
+		r.Limit = DefaultTopQueries
+	}
+	if r.Limit > MaxTopQueries {
+		r.Limit = MaxTopQueries
+	}
+	return nil
+}
+
+type SlowQueryReport struct {
+	Profiling  ProfilingConfig    `json:"profiling"`
+	Since      time.Time          `json:"since"`
+	Sampled    int                `json:"sampled"` // 기간 내 수집된 작업 수
+	TopQueries []*QueryShapeStats `json:"topQueries"`
+}
+
+// ConsoleRequest 읽기 전용 콘솔 명령
+// MongoDB 는 command + database/collection/filter..., Redis 는 command + args
+type ConsoleRequest struct {
+	Command string `json:"command"`
+
+	// MongoDB
+	Database   string                   `json:"database,omitempty"`
+	Collection string                   `json:"collection,omitempty"`
+	Filter     map[string]interface{}   `json:"filter,omitempty"`
+	Projection map[string]interface{}   `json:"projection,omitempty"`
+	Sort       map[string]interface{}   `json:"sort,omitempty"`
+	Pipeline   []map[string]interface{} `json:"pipeline,omitempty"`
+
+	// Redis
+	Args []string `json:"args,omitempty"`
+
+	Limit int `json:"limit,omitempty"`
+}
+
+// Validate 공통 검증, 명령별 허용 여부는 엔진에서 확인
+func (r *ConsoleRequest) Validate() error {
+	r.Command = strings.TrimSpace(r.Command)
+	if r.Command == "" {
+		return errors.NewMissingParameterError("command")
+	}
+	if r.Limit < 0 || r.Limit > MaxConsoleLimit {
+		return errors.NewInvalidParameterError("limit",
+			fmt.Sprintf("1~%d 사이여야 합니다", MaxConsoleLimit))
+	}
+	if r.Limit == 0 {
+		r.Limit = DefaultConsoleLimit
+	}
+	return nil
+}
+
+// StartImportRequest 업로드 파일 적재 옵션 (본문은 파일 자체라 쿼리 파라미터로 받음)
+type StartImportRequest struct {
+	Format     ImportFormat
+	Database   string // MongoDB json, csv 대상
+	Collection string
+	Drop       bool // 대상의 기존 데이터를 지우고 적재
+}
+
+// Validate 공통 검증, 형식별 허용 여부는 엔진에서 확인
+func (r *StartImportRequest) Validate() error {
+	r.Format = ImportFormat(strings.ToLower(strings.TrimSpace(string(r.Format))))
+	r.Database = strings.TrimSpace(r.Database)
+	r.Collection = strings.TrimSpace(r.Collection)
+	if r.Format == "" {
+		return errors.NewMissingParameterError("format")
+	}
+	return nil
+}
+
+// MaxImportBytes 업로드 파일 최대 크기, 압축 해제와 인덱스를 고려해 디스크의 절반까지
+func MaxImportBytes(resources ResourceSpec) int64 {
+	return int64(resources.Disk) << 30 / 2
+}
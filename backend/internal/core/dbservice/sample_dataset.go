@@ -0,0 +1,14 @@
+package dbservice
+
+// SampleDataset 학습용으로 백엔드에 내장된 샘플 데이터
+// 인스턴스 생성 시 지정하면 Running 이후 import Job 으로 적재됨
+type SampleDataset struct {
+	ID          string       `json:"id"`
+	Type        DBType       `json:"type"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Format      ImportFormat `json:"format"`
+	Database    string       `json:"database,omitempty"`
+	Collection  string       `json:"collection,omitempty"`
+	Examples    []string     `json:"examples"` // 적재 후 바로 해볼 수 있는 쿼리
+}
@@ -0,0 +1,122 @@
+package dbservice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+type PowerAction string
+
+const (
+	PowerActionStart PowerAction = "start"
+	PowerActionStop  PowerAction = "stop"
+)
+
+// ScheduleSkipReason 예정된 시작/중지를 실행하지 못한 이유
+type ScheduleSkipReason string
+
+const (
+	SkipInsufficientLemons ScheduleSkipReason = "insufficient_lemons"
+	SkipInvalidStatus      ScheduleSkipReason = "invalid_status"
+	SkipFailed             ScheduleSkipReason = "failed"
+)
+
+// PowerSchedule 인스턴스 자동 시작/중지 일정
+// cron 표현식은 Timezone 기준, 둘 중 하나만 지정할 수도 있음
+type PowerSchedule struct {
+	InstanceID int64
+	// 스케줄러가 StartInstance/StopInstance 호출할 때 사용 (조회 시 함께 채움)
+	InstanceExternalID string
+	UserID             string
+
+	Timezone  string
+	StartCron string
+	StopCron  string
+	Enabled   bool
+
+	// 다음 실행 예정, 비활성이거나 더 이상 실행할 시각이 없으면 nil
+	NextAction   PowerAction
+	NextActionAt *time.Time
+
+	LastAction     PowerAction
+	LastActionAt   *time.Time
+	LastSkippedAt  *time.Time
+	LastSkipReason ScheduleSkipReason
+	LastSkipDetail string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (p *PowerSchedule) ToResponse() *PowerScheduleResponse {
+	resp := &PowerScheduleResponse{
+		Timezone:     p.Timezone,
+		StartCron:    p.StartCron,
+		StopCron:     p.StopCron,
+		Enabled:      p.Enabled,
+		LastAction:   p.LastAction,
+		LastActionAt: p.LastActionAt,
+	}
+	if p.NextActionAt != nil {
+		resp.Next = &ScheduledAction{Action: p.NextAction, At: *p.NextActionAt}
+	}
+	if p.LastSkippedAt != nil {
+		resp.LastSkipped = &SkippedRun{
+			At:     *p.LastSkippedAt,
+			Reason: p.LastSkipReason,
+			Detail: p.LastSkipDetail,
+		}
+	}
+	return resp
+}
+
+type PowerScheduleResponse struct {
+	Timezone     string           `json:"timezone"`
+	StartCron    string           `json:"startCron,omitempty"`
+	StopCron     string           `json:"stopCron,omitempty"`
+	Enabled      bool             `json:"enabled"`
+	Next         *ScheduledAction `json:"nextAction,omitempty"`
+	LastAction   PowerAction      `json:"lastAction,omitempty"`
+	LastActionAt *time.Time       `json:"lastActionAt,omitempty"`
+	LastSkipped  *SkippedRun      `json:"lastSkipped,omitempty"`
+}
+
+type ScheduledAction struct {
+	Action PowerAction `json:"action"`
+	At     time.Time   `json:"at"`
+}
+
+type SkippedRun struct {
+	At     time.Time          `json:"at"`
+	Reason ScheduleSkipReason `json:"reason"`
+	Detail string             `json:"detail,omitempty"`
+}
+
+type UpdateScheduleRequest struct {
+	Timezone  string `json:"timezone" validate:"required"`
+	StartCron string `json:"startCron,omitempty" validate:"omitempty,cronschedule"`
+	StopCron  string `json:"stopCron,omitempty" validate:"omitempty,cronschedule"`
+	Enabled   *bool  `json:"enabled,omitempty"` // 기본값 true
+}
+
+func (r *UpdateScheduleRequest) Validate() error {
+	if r.StartCron == "" && r.StopCron == "" {
+		return errors.NewInvalidParameterError("request",
+			"startCron 과 stopCron 중 하나는 지정해야 합니다")
+	}
+	if r.StartCron != "" && r.StartCron == r.StopCron {
+		return errors.NewInvalidParameterError("stopCron",
+			"startCron 과 같은 시각으로 지정할 수 없습니다")
+	}
+	if _, err := time.LoadLocation(r.Timezone); err != nil {
+		return errors.NewInvalidParameterError("timezone",
+			fmt.Sprintf("알 수 없는 시간대입니다: %s (예: 'Asia/Seoul')", r.Timezone))
+	}
+	if r.Enabled == nil {
+		enabled := true
+		r.Enabled = &enabled
+	}
+	return nil
+}
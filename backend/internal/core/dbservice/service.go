@@ -2,30 +2,91 @@ package dbservice
 
 import (
 	"context"
+	"io"
+
+	"github.com/piper-hyowon/dBtree/internal/core/job"
 )
 
 type Service interface {
 	// CRUD
 	CreateInstance(ctx context.Context, userID string, userLemon int, req *CreateInstanceRequest) (*CreateInstanceResponse, error)
-	ListInstances(ctx context.Context, userID string) ([]*DBInstance, error)
+	// ListInstances 필터/정렬/페이지네이션 적용, 전체 개수 함께 반환 (req.Deleted 면 휴지통 목록)
+	ListInstances(ctx context.Context, userID string, req *ListInstancesRequest) ([]*DBInstance, int, error)
+	// UpdateMetadata 라벨/설명 변경, 라벨은 CRD 에도 반영
+	UpdateMetadata(ctx context.Context, userID, instanceID string, req *UpdateMetadataRequest) (*DBInstance, error)
+	// QuoteInstance 생성 요청과 같은 본문으로 비용/스펙/거절 사유 미리보기, 아무것도 만들지 않음
+	QuoteInstance(ctx context.Context, userID string, userLemon int, req *CreateInstanceRequest) (*InstanceQuote, error)
 	UpdateInstance(ctx context.Context, userID, instanceID string, req *UpdateInstanceRequest) (*DBInstance, error)
-	DeleteInstance(ctx context.Context, userID, instanceID string) error
+	// DeleteInstance 기본은 휴지통 이동 (보관 기간 동안 복구 가능), permanent 면 바로 리소스 정리
+	// 실제 K8s 작업은 작업 큐에서 실행
+	DeleteInstance(ctx context.Context, userID, instanceID string, permanent bool) (*job.Job, error)
+	UndeleteInstance(ctx context.Context, userID, instanceID string) (*DBInstance, error)
+	// PurgeExpiredInstances 보관 기간이 지난 휴지통 인스턴스 정리, 정리한 개수 반환
+	PurgeExpiredInstances(ctx context.Context, limit int) (int, error)
 
 	// Control
 
 	StartInstance(ctx context.Context, userID, instanceID string) error
 	StopInstance(ctx context.Context, userID, instanceID string) error
-	RestartInstance(ctx context.Context, userID, instanceID string) error
+	RestartInstance(ctx context.Context, userID, instanceID string) (*job.Job, error)
+
+	// ResumeInstance 유휴 자동 중지된 인스턴스만 다시 시작
+	ResumeInstance(ctx context.Context, userID, instanceID string) error
+
+	// Idle
+
+	UpdateIdlePolicy(ctx context.Context, userID, instanceID string, req *UpdateIdlePolicyRequest) (*IdlePolicy, error)
+	// PauseIdleInstances 정책 시간 이상 유휴 상태인 인스턴스 중지, 중지한 인스턴스 반환
+	PauseIdleInstances(ctx context.Context) ([]*DBInstance, error)
+
+	// Schedule
+
+	GetSchedule(ctx context.Context, userID, instanceID string) (*PowerSchedule, error)
+	UpdateSchedule(ctx context.Context, userID, instanceID string, req *UpdateScheduleRequest) (*PowerSchedule, error)
+	DeleteSchedule(ctx context.Context, userID, instanceID string) error
+	// RunDueSchedules 실행 시각이 된 일정의 시작/중지 실행, 실행한 건수 반환 (건너뛴 것 제외)
+	RunDueSchedules(ctx context.Context, limit int) (int, error)
 
 	// Status Sync
 
 	GetInstanceWithSync(ctx context.Context, userID, instanceID string) (*DBInstance, error)
+	ListInstanceEvents(ctx context.Context, userID, instanceID string) ([]*InstanceEvent, error)
+	// StreamInstanceLogs DB 파드 로그 스트림, 호출자가 Close 해야 함
+	StreamInstanceLogs(ctx context.Context, userID, instanceID string, req *InstanceLogsRequest) (io.ReadCloser, error)
+
+	// Insights
+
+	UpdateProfiling(ctx context.Context, userID, instanceID string, req *UpdateProfilingRequest) (*ProfilingConfig, error)
+	SlowQueries(ctx context.Context, userID, instanceID string, req *SlowQueryRequest) (*SlowQueryReport, error)
+
+	// Console
+
+	RunConsoleCommand(ctx context.Context, userID, instanceID string, req *ConsoleRequest) (*ConsoleResult, error)
+
+	// Import
+
+	// StartImport body 를 임시 보관하고 오퍼레이터에 적재 요청
+	StartImport(ctx context.Context, userID, instanceID string, req *StartImportRequest, body io.Reader) (*DataImport, error)
+	ListImports(ctx context.Context, userID, instanceID string) ([]*DataImport, error)
+	GetImport(ctx context.Context, userID, instanceID, importID string) (*DataImport, error)
+	// OpenImportPayload import Job 이 내려받을 파일, 토큰이 맞고 끝나지 않은 요청만 허용
+	OpenImportPayload(ctx context.Context, importID, token string) (io.ReadCloser, int64, error)
+
+	// Access
+
+	RotateCredentials(ctx context.Context, userID, instanceID string) (*RotateCredentialsResponse, error)
+
+	GetAllowlist(ctx context.Context, userID, instanceID string) (*AllowlistResponse, error)
+	UpdateAllowlist(ctx context.Context, userID, instanceID string, req *UpdateAllowlistRequest) (*AllowlistResponse, error)
 
 	// Backup
 
 	CreateBackup(ctx context.Context, userID, instanceID string, name string) (*BackupRecord, error)
 	ListBackups(ctx context.Context, userID, instanceID string) ([]*BackupRecord, error)
 	RestoreFromBackup(ctx context.Context, userID, instanceID string, backupID string) error
+	IssueBackupDownload(ctx context.Context, userID, instanceID, backupID string, client ClientInfo) (*BackupDownload, error)
+	// OpenBackupDownload 서명된 다운로드 주소 검증 후 아카이브 스트림, 소유자와 만료를 확인
+	OpenBackupDownload(ctx context.Context, signed *SignedBackupURL, client ClientInfo) (*BackupArchive, error)
 
 	// Metrics
 
@@ -33,5 +94,15 @@ type Service interface {
 
 	// Presets
 
+	// ListPresets 시스템 프리셋과 갤러리에 공개된 사용자 프리셋
 	ListPresets(ctx context.Context) ([]*DBPreset, error)
+	// CreatePreset 본인 인스턴스의 현재 스펙을 비공개 프리셋으로 저장
+	CreatePreset(ctx context.Context, userID string, req *CreatePresetRequest) (*DBPreset, error)
+	ListUserPresets(ctx context.Context, userID string) ([]*DBPreset, error)
+	DeletePreset(ctx context.Context, userID, presetID string) error
+	// PublishPreset 갤러리 공개 신청, 관리자 심사(ModeratePreset) 후 공개
+	PublishPreset(ctx context.Context, userID, presetID string) (*DBPreset, error)
+	ListPendingPresets(ctx context.Context) ([]*DBPreset, error)
+	ModeratePreset(ctx context.Context, presetID string, req *ModeratePresetRequest) (*DBPreset, error)
+	ListSampleDatasets(ctx context.Context, dbType DBType) ([]*SampleDataset, error)
 }
@@ -8,6 +8,8 @@ import (
 type DBInstanceStore interface {
 	Create(ctx context.Context, instance *DBInstance) error
 	Find(ctx context.Context, externalID string) (*DBInstance, error)
+	FindByID(ctx context.Context, id int64) (*DBInstance, error)
+	// FindByUserAndName 이름을 쓰고 있는 인스턴스, 정리 전인 휴지통 인스턴스도 포함
 	FindByUserAndName(ctx context.Context, userID string, name string) (*DBInstance, error)
 	List(ctx context.Context, userID string) ([]*DBInstance, error)
 	ListRunning(ctx context.Context) ([]*DBInstance, error)
@@ -15,15 +17,66 @@ type DBInstanceStore interface {
 	ListPausedBefore(ctx context.Context, before time.Time) ([]*DBInstance, error)
 	Update(ctx context.Context, instance *DBInstance) error
 	UpdateStatus(ctx context.Context, id int64, status InstanceStatus, reason string) error
+	// UpdateSpec 리소스/크기/비용/설정/백업 설정 저장, priceChange 가 있으면 같은 트랜잭션에서 이력 기록
+	UpdateSpec(ctx context.Context, instance *DBInstance, priceChange *PriceChange) error
+	UpdateAllowedCIDRs(ctx context.Context, id int64, cidrs []string) error
+	// UpdateProfiling nil 이면 설정 제거
+	UpdateProfiling(ctx context.Context, id int64, profiling *ProfilingConfig) error
+	// UpdateIdlePolicy nil 이면 설정 제거, idle_since 초기화
+	UpdateIdlePolicy(ctx context.Context, id int64, policy *IdlePolicy) error
+	UpdateIdleSince(ctx context.Context, id int64, since *time.Time) error
+	// ListIdlePolicyEnabled 자동 중지 정책이 켜진 실행 중 인스턴스
+	ListIdlePolicyEnabled(ctx context.Context) ([]*DBInstance, error)
+	// SyncK8sStatus 오퍼레이터가 보고한 상태 반영, 삭제 중인 인스턴스는 무시
+	SyncK8sStatus(ctx context.Context, externalID string, status *K8sStatus) error
 	UpdateBillingTime(ctx context.Context, id int64, billedAt time.Time) error
 	Delete(ctx context.Context, externalID string) error
 
+	// FindIncludingDeleted 삭제/정리된 인스턴스까지 조회 (삭제 작업용)
+	FindIncludingDeleted(ctx context.Context, externalID string) (*DBInstance, error)
+	// FindDeleted 휴지통에 있는 인스턴스, 없으면 nil
+	FindDeleted(ctx context.Context, externalID string) (*DBInstance, error)
+	// ListPurgeDue 보관 기간이 지난 휴지통 인스턴스
+	ListPurgeDue(ctx context.Context, now time.Time, limit int) ([]*DBInstance, error)
+	// SoftDelete 중지 상태로 휴지통에 넣고 purgeAfter 까지 보관
+	SoftDelete(ctx context.Context, id int64, purgeAfter time.Time) error
+	// Undelete 보관 기간 안에서만 복구, 같은 이름의 인스턴스가 있으면 충돌
+	Undelete(ctx context.Context, instance *DBInstance) error
+	// MarkPurged K8s 리소스까지 정리 완료
+	MarkPurged(ctx context.Context, id int64) error
+
 	CountActive(ctx context.Context, userID string) (int, error)
 
+	// Search 라벨 셀렉터/상태/타입 필터와 정렬, 페이지네이션, 전체 개수 함께 반환
+	Search(ctx context.Context, userID string, req *ListInstancesRequest) ([]*DBInstance, int, error)
+	UpdateMetadata(ctx context.Context, id int64, labels map[string]string, description string) error
+
 	CreateBackup(ctx context.Context, backup *BackupRecord) error
 	FindBackup(ctx context.Context, backupID string) (*BackupRecord, error)
 	ListBackups(ctx context.Context, instanceID string) ([]*BackupRecord, error)
 	UpdateBackupStatus(ctx context.Context, backupID string, status BackupStatus, errorMsg string) error
+	RecordBackupDownload(ctx context.Context, audit *BackupDownloadAudit) error
+
+	CreateCredentialRotation(ctx context.Context, rotation *CredentialRotation) error
+	// CompleteCredentialRotation 오퍼레이터 처리 결과 반영, rotatedAt 이 요청 이후면 applied 아니면 failed
+	CompleteCredentialRotation(ctx context.Context, rotationID string, rotatedAt *time.Time) error
+
+	CreateImport(ctx context.Context, imp *DataImport) error
+	FindImport(ctx context.Context, importID string) (*DataImport, error)
+	ListImports(ctx context.Context, instanceID int64) ([]*DataImport, error)
+	// UpdateImportStatus 이미 끝난 요청은 무시, 완료/실패면 completed_at 기록
+	UpdateImportStatus(ctx context.Context, importID string, status ImportStatus, errorMsg string) error
+
+	// UpsertSchedule 일정 저장, 실행 기록은 유지
+	UpsertSchedule(ctx context.Context, schedule *PowerSchedule) error
+	// FindSchedule 없으면 nil
+	FindSchedule(ctx context.Context, instanceID int64) (*PowerSchedule, error)
+	ListSchedules(ctx context.Context, userID string) ([]*PowerSchedule, error)
+	DeleteSchedule(ctx context.Context, instanceID int64) error
+	// ListDueSchedules 활성 일정 중 next_action_at 이 now 이전인 것, 삭제된 인스턴스 제외
+	ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*PowerSchedule, error)
+	// RecordScheduleRun 실행/건너뜀 결과와 다음 실행 예정 저장
+	RecordScheduleRun(ctx context.Context, schedule *PowerSchedule) error
 
 	TotalCreated(ctx context.Context) (int, error)
 
@@ -32,7 +85,19 @@ type DBInstanceStore interface {
 
 type PresetStore interface {
 	Find(ctx context.Context, id string) (*DBPreset, error)
+	// ListByType 시스템 프리셋과 갤러리에 공개된 사용자 프리셋
 	ListByType(ctx context.Context, dbType DBType) ([]*DBPreset, error)
+
+	// 사용자 프리셋
+
+	Create(ctx context.Context, preset *DBPreset) error
+	ListByOwner(ctx context.Context, ownerID string) ([]*DBPreset, error)
+	CountByOwner(ctx context.Context, ownerID string) (int, error)
+	// ListPending 공개 심사 대기 목록, 오래된 신청부터
+	ListPending(ctx context.Context) ([]*DBPreset, error)
+	// UpdateVisibility 현재 상태가 from 중 하나일 때만 변경, 아니면 상태 전이 에러
+	UpdateVisibility(ctx context.Context, id string, from []PresetVisibility, visibility PresetVisibility, note string) error
+	Delete(ctx context.Context, id, ownerID string) error
 }
 
 type PortStore interface {
@@ -40,3 +105,19 @@ type PortStore interface {
 	ReleasePort(ctx context.Context, instanceID string) error
 	GetPort(ctx context.Context, instanceID string) (int, error)
 }
+
+// ProvisionSagaStore 인스턴스 생성 사가 상태, 단계 기록 시 ProvisionSagaLease 만큼 점유 연장
+type ProvisionSagaStore interface {
+	// CreateWithInstance 인스턴스와 사가를 한 트랜잭션으로 저장
+	CreateWithInstance(ctx context.Context, instance *DBInstance, saga *ProvisionSaga) error
+	SetStep(ctx context.Context, sagaID int64, step ProvisionStep, status ProvisionStepStatus, errMsg string) error
+	// Update 상태, 옵션, 마지막 에러 저장
+	Update(ctx context.Context, saga *ProvisionSaga) error
+	// ClaimStale 점유가 만료된 진행/보상 중 사가를 가져오고 시도 횟수 증가
+	ClaimStale(ctx context.Context, limit int) ([]*ProvisionSaga, error)
+	// Claim 아직 아무도 처리하지 않았거나 점유가 만료된 사가만 가져옴, 아니면 nil
+	Claim(ctx context.Context, sagaID int64) (*ProvisionSaga, error)
+	Find(ctx context.Context, sagaID int64) (*ProvisionSaga, error)
+	// Release 점유 해제, 다음 시도가 바로 가져갈 수 있음
+	Release(ctx context.Context, sagaID int64) error
+}
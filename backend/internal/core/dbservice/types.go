@@ -38,14 +38,11 @@ const (
 )
 
 func (t DBType) DefaultMode() DBMode {
-	switch t {
-	case MongoDB:
-		return ModeStandalone
-	case Redis:
-		return ModeBasic
-	default:
+	engine, ok := LookupEngine(t)
+	if !ok || len(engine.Modes()) == 0 {
 		return ""
 	}
+	return engine.Modes()[0]
 }
 
 type InstanceStatus string
@@ -114,6 +111,8 @@ type DBInstance struct {
 	Size              DBSize
 	Mode              DBMode
 	CreatedFromPreset *string
+	Labels            map[string]string
+	Description       string
 
 	// 스펙
 	Resources ResourceSpec
@@ -135,15 +134,54 @@ type DBInstance struct {
 	Config       map[string]interface{}
 	BackupConfig BackupConfig
 
+	// 외부 접속 허용 CIDR, 비어 있으면 전체 허용
+	AllowedCIDRs []string
+
+	// 느린 작업 수집 설정, nil 이면 비활성
+	Profiling *ProfilingConfig
+
+	// 유휴 자동 중지 정책, nil 이면 비활성
+	IdlePolicy *IdlePolicy
+	IdleSince  *time.Time
+
+	// 자동 시작/중지 일정, 조회 API 에서만 채움
+	Schedule *PowerSchedule
+
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	LastBilledAt *time.Time
 	PausedAt     *time.Time
 	DeletedAt    *time.Time
+	PurgeAfter   *time.Time // 휴지통 보관 만료, 이후 리소스 정리
+	PurgedAt     *time.Time
+
+	// 오퍼레이터가 보고한 상태 (StatusSyncer 가 갱신)
+	Conditions  []K8sCondition
+	Metrics     *InstanceMetrics
+	K8sSyncedAt *time.Time
+}
+
+// K8sCondition DBInstance CRD 의 status.conditions 항목
+type K8sCondition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// K8sStatus informer 로 받은 CRD status 중 DB 에 반영하는 필드
+type K8sStatus struct {
+	Status       InstanceStatus
+	StatusReason string
+	Endpoint     string
+	Port         int
+	Conditions   []K8sCondition
+	Metrics      *InstanceMetrics
 }
 
 func (d *DBInstance) ToResponse() *InstanceResponse {
-	return &InstanceResponse{
+	resp := &InstanceResponse{
 		ID:                d.ExternalID,
 		Name:              d.Name,
 		Type:              d.Type,
@@ -161,13 +199,25 @@ func (d *DBInstance) ToResponse() *InstanceResponse {
 		CreatedAt:         d.CreatedAt,
 		UpdatedAt:         d.UpdatedAt,
 		CreatedFromPreset: d.CreatedFromPreset,
+		Labels:            d.Labels,
+		Description:       d.Description,
 		PausedAt:          d.PausedAt,
+		Conditions:        d.Conditions,
+		Metrics:           d.Metrics,
+		DeletedAt:         d.DeletedAt,
+		PurgeAfter:        d.PurgeAfter,
+		IdlePolicy:        d.IdlePolicy,
+		IdleSince:         d.IdleSince,
+	}
+	if d.Schedule != nil {
+		resp.Schedule = d.Schedule.ToResponse()
 	}
+	return resp
 }
 
 func (d *DBInstance) CanTransitionTo(target InstanceStatus) bool {
 	transitions := map[InstanceStatus][]InstanceStatus{
-		StatusProvisioning: {StatusRunning, StatusError},
+		StatusProvisioning: {StatusRunning, StatusRestoring, StatusError},
 		StatusRunning:      {StatusPaused, StatusStopped, StatusMaintenance, StatusBackingUp, StatusDeleting},
 		StatusPaused:       {StatusRunning, StatusDeleting},
 		StatusStopped:      {StatusRunning, StatusDeleting},
@@ -217,13 +267,21 @@ type DBPreset struct {
 	Resources           ResourceSpec
 	Cost                LemonCost
 	DefaultConfig       map[string]interface{}
+	SampleDataset       string // 기본 샘플 데이터셋 ID, 비어 있으면 없음
 	SortOrder           int
 	Available           bool // 현재 리소스 상황에서 사용 가능 여부
 	UnavailableReason   string
+
+	// 사용자 프리셋, 시스템 프리셋은 OwnerID 가 비어 있음
+	OwnerID        string
+	Visibility     PresetVisibility
+	ModerationNote string
+	Backup         BackupConfig // 프리셋으로 생성할 때 요청에 백업 설정이 없으면 적용
+	CreatedAt      time.Time
 }
 
 func (p *DBPreset) ToResponse() PresetResponse {
-	return PresetResponse{
+	resp := PresetResponse{
 		ID:                  p.ID,
 		Type:                p.Type,
 		Size:                p.Size,
@@ -237,10 +295,18 @@ func (p *DBPreset) ToResponse() PresetResponse {
 		Resources:           p.Resources,
 		Cost:                p.Cost.ToResponse(),
 		DefaultConfig:       p.DefaultConfig,
+		SampleDataset:       p.SampleDataset,
 		SortOrder:           p.SortOrder,
 		Available:           p.Available,
 		UnavailableReason:   p.UnavailableReason,
+		Visibility:          p.Visibility,
+		ModerationNote:      p.ModerationNote,
+	}
+	if p.Backup.Enabled {
+		backup := p.Backup
+		resp.Backup = &backup
 	}
+	return resp
 }
 
 // BackupRecord (백업 요청 메타데이터만, 실제 백업은 K8s)
@@ -276,6 +342,32 @@ const (
 	BackupStatusFailed    BackupStatus = "failed"
 )
 
+// CredentialRotation 루트 비밀번호 교체 이력
+type CredentialRotation struct {
+	ID          string                   `json:"id"` // external_id
+	InstanceID  int64                    `json:"-"`
+	Status      CredentialRotationStatus `json:"status"`
+	RequestedAt time.Time                `json:"requestedAt"`
+	CompletedAt *time.Time               `json:"completedAt,omitempty"`
+}
+
+type CredentialRotationStatus string
+
+const (
+	CredentialRotationPending CredentialRotationStatus = "pending" // 오퍼레이터 적용 대기
+	CredentialRotationApplied CredentialRotationStatus = "applied"
+	CredentialRotationFailed  CredentialRotationStatus = "failed" // 이전 비밀번호 유지
+)
+
+// InstanceEvent 인스턴스 라이프사이클 이벤트 (오퍼레이터 status.history)
+type InstanceEvent struct {
+	Time    time.Time      `json:"time"`
+	State   InstanceStatus `json:"state,omitempty"`
+	Type    string         `json:"type"` // Normal, Warning
+	Reason  string         `json:"reason"`
+	Message string         `json:"message,omitempty"`
+}
+
 type InstanceMetrics struct {
 	InstanceID          uuid.UUID `json:"instanceId"`
 	CPUUsage            string    `json:"cpuUsage"`
@@ -290,12 +382,9 @@ type InstanceMetrics struct {
 func CalculateCustomCost(dbType DBType, resources ResourceSpec) LemonCost {
 	var base float64
 
-	// 메모리 기반 비용
-	switch dbType {
-	case Redis:
-		base = float64(resources.Memory) / 512 // 512MB당 1레몬
-	case MongoDB:
-		base = float64(resources.Memory) / 1024 * 3 // 1GB당 3레몬
+	// 메모리 기반 비용 (엔진별)
+	if engine, ok := LookupEngine(dbType); ok {
+		base = engine.MemoryCost(resources.Memory)
 	}
 
 	// CPU 추가 비용 (0.5 vCPU 초과분에 대해)
@@ -338,6 +427,18 @@ func (d *DBInstance) ToCreateResponse(credentials *Credentials) *CreateInstanceR
 	}
 }
 
+// PriceChange 스펙 변경에 따른 시간당 비용 변경 이력
+// 과금 스케줄러는 과금 시점의 hourly_cost 를 쓰므로 EffectiveFrom(다음 과금 주기)부터 새 비용 적용
+type PriceChange struct {
+	InstanceID           int64
+	PreviousSize         DBSize
+	NewSize              DBSize
+	PreviousHourlyLemons int
+	NewHourlyLemons      int
+	EffectiveFrom        time.Time
+	CreatedAt            time.Time
+}
+
 type UserInstanceSummary struct {
 	ID   string `json:"id"` // external_id
 	Name string `json:"name"`
@@ -6,6 +6,8 @@ type Service interface {
 	SendOTP(ctx context.Context, to string, code string) error
 	SendWelcome(ctx context.Context, to string) error
 	SendGoodbye(ctx context.Context, to string) error
+	// SendIdlePaused 유휴 자동 중지 안내, resumeURL 은 재개 버튼 링크
+	SendIdlePaused(ctx context.Context, to string, instanceName string, idleHours int, resumeURL string) error
 	Send(ctx context.Context, to string, subject string, body string) error
 	SendWithImages(ctx context.Context, to string, subject string, htmlBody string, images map[string][]byte) error
 	Close() // 리소스 정리
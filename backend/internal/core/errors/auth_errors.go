@@ -79,6 +79,15 @@ func NewUnauthorizedError() DomainError {
 	)
 }
 
+func NewForbiddenError() DomainError {
+	return NewError(
+		ErrForbidden,
+		"권한이 없는 요청입니다",
+		nil,
+		nil,
+	)
+}
+
 func NewSessionExpiredError() DomainError {
 	return NewError(
 		ErrSessionExpired,
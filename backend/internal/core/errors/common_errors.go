@@ -104,3 +104,21 @@ func NewLimitExceededError(resource string, limit int) DomainError {
 		nil,
 	)
 }
+
+func NewIdempotencyKeyReusedError(key string) DomainError {
+	return NewError(
+		ErrIdempotencyKeyReused,
+		"이미 다른 요청에 사용한 Idempotency-Key 입니다",
+		map[string]string{"idempotencyKey": key},
+		nil,
+	)
+}
+
+func NewRequestInProgressError(retryAfterSeconds int) DomainError {
+	return NewError(
+		ErrRequestInProgress,
+		"같은 Idempotency-Key 요청을 처리 중입니다",
+		map[string]int{"waitSeconds": retryAfterSeconds},
+		nil,
+	)
+}
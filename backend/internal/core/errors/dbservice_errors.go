@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 )
 
 func NewInvalidStatusTransitionError(current, target string) DomainError {
@@ -22,6 +23,15 @@ func NewInstanceNameConflictError(name string) DomainError {
 	)
 }
 
+func NewInstanceNameInRecycleBinError(name string) DomainError {
+	return NewError(
+		ErrResourceConflict,
+		fmt.Sprintf("'%s' 인스턴스가 휴지통에 있습니다. 복구하거나 보관 기간이 지난 뒤 사용하세요", name),
+		map[string]string{"name": name},
+		nil,
+	)
+}
+
 func NewInsufficientLemonsForInstanceError(required, current int) DomainError {
 	return NewError(
 		ErrInsufficientLemons,
@@ -30,3 +40,76 @@ func NewInsufficientLemonsForInstanceError(required, current int) DomainError {
 		nil,
 	)
 }
+
+func NewInstanceNotReadyError(status string) DomainError {
+	return NewError(
+		ErrInstanceNotReady,
+		fmt.Sprintf("실행 중인 인스턴스에서만 가능합니다 (현재: %s)", status),
+		map[string]string{"status": status},
+		nil,
+	)
+}
+
+func NewCredentialRotationInProgressError() DomainError {
+	return NewError(
+		ErrResourceConflict,
+		"이전 비밀번호 교체가 아직 적용 중입니다",
+		nil,
+		nil,
+	)
+}
+
+// NewCommandFailedError 인스턴스에서 실행한 클라이언트 명령 실패, output 은 클라이언트 출력 일부
+func NewCommandFailedError(output string) DomainError {
+	return NewError(
+		ErrCommandFailed,
+		"인스턴스에서 명령 실행에 실패했습니다",
+		map[string]string{"output": output},
+		nil,
+	)
+}
+
+func NewCommandTimeoutError(timeout time.Duration) DomainError {
+	return NewError(
+		ErrCommandTimeout,
+		fmt.Sprintf("명령 실행 시간이 %s 를 초과했습니다", timeout),
+		map[string]string{"timeout": timeout.String()},
+		nil,
+	)
+}
+
+func NewResultTooLargeError(maxBytes int) DomainError {
+	return NewError(
+		ErrLimitExceeded,
+		fmt.Sprintf("결과가 너무 큽니다 (최대 %dKB), 조건을 좁히거나 SCAN 계열 명령을 사용하세요", maxBytes/1024),
+		map[string]int{"maxBytes": maxBytes},
+		nil,
+	)
+}
+
+func NewImportInProgressError() DomainError {
+	return NewError(
+		ErrResourceConflict,
+		"이전 데이터 가져오기가 아직 진행 중입니다",
+		nil,
+		nil,
+	)
+}
+
+func NewImportTooLargeError(maxBytes int64) DomainError {
+	return NewError(
+		ErrLimitExceeded,
+		fmt.Sprintf("업로드 파일이 너무 큽니다 (최대 %dMB, 디스크 크기의 절반)", maxBytes>>20),
+		map[string]int64{"maxBytes": maxBytes},
+		nil,
+	)
+}
+
+func NewInvalidDownloadLinkError() DomainError {
+	return NewError(
+		ErrInvalidToken,
+		"다운로드 링크가 유효하지 않거나 만료되었습니다",
+		nil,
+		nil,
+	)
+}
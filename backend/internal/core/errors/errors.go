@@ -23,6 +23,10 @@ const (
 	ErrMissingParameter ErrorCode = 1101
 	ErrMethodNotAllowed ErrorCode = 1102
 	ErrEndpointNotFound ErrorCode = 1103
+	// ErrIdempotencyKeyReused 같은 Idempotency-Key 를 다른 요청에 사용
+	ErrIdempotencyKeyReused ErrorCode = 1104
+	// ErrRequestInProgress 같은 Idempotency-Key 요청이 아직 처리 중
+	ErrRequestInProgress ErrorCode = 1105
 
 	ErrInvalidOTP      ErrorCode = 1200
 	ErrExpiredOTP      ErrorCode = 1201
@@ -33,6 +37,7 @@ const (
 	ErrUnauthorized    ErrorCode = 1206
 	ErrSessionExpired  ErrorCode = 1207
 	ErrAlreadyVerified ErrorCode = 1208
+	ErrForbidden       ErrorCode = 1209
 
 	ErrInvalidEmail ErrorCode = 1301
 
@@ -56,6 +61,8 @@ const (
 	ErrInvalidInstanceName     ErrorCode = 1702
 	ErrInvalidResourceSpec     ErrorCode = 1703
 	ErrInstanceNotReady        ErrorCode = 1704
+	ErrCommandFailed           ErrorCode = 1705
+	ErrCommandTimeout          ErrorCode = 1706
 
 	ErrLimitExceeded ErrorCode = 1805
 
@@ -70,6 +77,8 @@ var errorStrings = map[ErrorCode]string{
 	ErrMissingParameter:        "missing_parameter",
 	ErrMethodNotAllowed:        "method_not_allowed",
 	ErrEndpointNotFound:        "endpoint_not_found",
+	ErrIdempotencyKeyReused:    "idempotency_key_reused",
+	ErrRequestInProgress:       "request_in_progress",
 	ErrInvalidOTP:              "invalid_otp",
 	ErrExpiredOTP:              "expired_otp",
 	ErrSessionNotFound:         "session_not_found",
@@ -79,6 +88,7 @@ var errorStrings = map[ErrorCode]string{
 	ErrUnauthorized:            "unauthorized",
 	ErrSessionExpired:          "session_expired",
 	ErrAlreadyVerified:         "already_verified",
+	ErrForbidden:               "forbidden",
 	ErrInvalidEmail:            "invalid_email",
 	ErrResourceNotFound:        "resource_not_found",
 	ErrResourceConflict:        "resource_conflict",
@@ -96,6 +106,8 @@ var errorStrings = map[ErrorCode]string{
 	ErrInvalidInstanceName:     "invalid_instance_name",
 	ErrInvalidResourceSpec:     "invalid_resource_spec",
 	ErrInstanceNotReady:        "instance_not_ready",
+	ErrCommandFailed:           "command_failed",
+	ErrCommandTimeout:          "command_timeout",
 	ErrLimitExceeded:           "limit_exceeded",
 	ErrResourceExhausted:       "resource_exhausted",
 	ErrSystemCapacity:          "system_capacity_exceeded",
@@ -0,0 +1,21 @@
+package job
+
+import (
+	"context"
+)
+
+type EnqueueRequest struct {
+	Type        Type
+	UserID      string
+	ResourceID  string
+	Payload     any // JSON 으로 저장
+	MaxAttempts int // 0 이면 DefaultMaxAttempts
+}
+
+// Queue 오래 걸리는 작업을 요청 밖에서 실행, 요청은 작업 ID 로 진행 상황 조회
+type Queue interface {
+	Register(jobType Type, handler Handler)
+	Enqueue(ctx context.Context, req *EnqueueRequest) (*Job, error)
+	// Find 본인 작업만 조회 가능
+	Find(ctx context.Context, userID, id string) (*Job, error)
+}
@@ -0,0 +1,18 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+type Store interface {
+	Create(ctx context.Context, job *Job) error
+	Find(ctx context.Context, id string) (*Job, error)
+	// ClaimDue 실행할 때가 된 작업과 점유가 만료된 실행 중 작업을 running 으로 가져오고 시도 횟수 증가
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Job, error)
+	Complete(ctx context.Context, id string) error
+	// Retry runAt 이후 다시 실행되도록 pending 으로 되돌림
+	Retry(ctx context.Context, id string, runAt time.Time, errMsg string) error
+	// Fail failed 또는 dead 로 종료
+	Fail(ctx context.Context, id string, status Status, errMsg string) error
+}
@@ -0,0 +1,121 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Type 작업 종류, 도메인 패키지에서 정의하고 Queue.Register 로 핸들러 등록
+type Type string
+
+type Status string
+
+const (
+	StatusPending   Status = "pending" // 대기 중 (재시도 대기 포함)
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed" // 재시도해도 소용없는 실패
+	StatusDead      Status = "dead"   // 재시도 횟수 초과, 수동 확인 필요
+)
+
+func (s Status) Finished() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusDead
+}
+
+const (
+	DefaultMaxAttempts = 5
+	// Lease 실행 중인 작업의 점유 시간, 만료되면(서버 종료 등) 다른 워커가 다시 실행
+	Lease = 5 * time.Minute
+
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = 5 * time.Minute
+)
+
+// RetryDelay attempt 번째 실패 후 대기 시간, 지수 백오프
+func RetryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
+
+type Job struct {
+	ID          string
+	Type        Type
+	UserID      string
+	ResourceID  string // 대상 리소스 (인스턴스 ExternalID 등)
+	Payload     json.RawMessage
+	Status      Status
+	Attempts    int
+	MaxAttempts int
+	LastError   string
+	RunAt       time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// LastAttempt 이번 실행이 실패하면 더 재시도하지 않음
+func (j *Job) LastAttempt() bool {
+	return j.Attempts >= j.MaxAttempts
+}
+
+type JobResponse struct {
+	ID          string     `json:"id"`
+	Type        Type       `json:"type"`
+	ResourceID  string     `json:"resourceId,omitempty"`
+	Status      Status     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"maxAttempts"`
+	Error       string     `json:"error,omitempty"`
+	NextRunAt   *time.Time `json:"nextRunAt,omitempty"` // 재시도 대기 중일 때
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func (j *Job) ToResponse() *JobResponse {
+	resp := &JobResponse{
+		ID:          j.ID,
+		Type:        j.Type,
+		ResourceID:  j.ResourceID,
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		Error:       j.LastError,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+		CompletedAt: j.CompletedAt,
+	}
+	if j.Status == StatusPending && j.Attempts > 0 {
+		resp.NextRunAt = &j.RunAt
+	}
+	return resp
+}
+
+// Handler 실패하면 재시도, Permanent 로 감싼 에러는 재시도하지 않음
+// ctx 는 Lease 또는 종료 시 취소됨
+type Handler func(ctx context.Context, job *Job) error
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 재시도하지 않을 실패
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
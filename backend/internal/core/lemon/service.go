@@ -22,6 +22,12 @@ type Service interface {
 
 	AddLemons(ctx context.Context, userID string, amount int, actionType ActionType, note string, instanceID *int64) error
 	DeductLemons(ctx context.Context, userID string, amount int, actionType ActionType, note string, instanceID *int64) error
+	HasInstanceTransaction(ctx context.Context, instanceID int64, actionType ActionType) (bool, error)
+
+	/* --------환불 실패-------- */
+
+	RecordRefundFailure(ctx context.Context, failure *RefundFailure) error
+	RetryRefundFailures(ctx context.Context, limit int) (int, error) // 환불된 건수 반환
 
 	/* --------유저 데이터 조회-------- */
 
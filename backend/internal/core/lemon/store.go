@@ -25,4 +25,12 @@ type Store interface {
 
 	UserTransactionCount(ctx context.Context, userID string, instanceName *string) (int, error)
 	UserTransactionsWithInstance(ctx context.Context, userID string, instanceName *string, limit, offset int) ([]*TransactionWithInstance, error)
+
+	// InstanceTransactionExists 인스턴스에 해당 종류의 성공한 트랜잭션이 있는지, 중복 차감/환불 방지용
+	InstanceTransactionExists(ctx context.Context, instanceID int64, actionType ActionType) (bool, error)
+
+	CreateRefundFailure(ctx context.Context, failure *RefundFailure) error
+	UnresolvedRefundFailures(ctx context.Context, limit int) ([]*RefundFailure, error)
+	ResolveRefundFailure(ctx context.Context, id int64) error
+	IncrementRefundRetry(ctx context.Context, id int64, errMsg string) error
 }
@@ -94,3 +94,16 @@ type TransactionWithInstance struct {
 	CreatedAt    time.Time  `json:"createdAt"`
 	Note         string     `json:"note"`
 }
+
+// RefundFailure 실패한 환불, 워커가 재시도해서 resolved 처리
+type RefundFailure struct {
+	ID           int64
+	UserID       string
+	Amount       int
+	InstanceID   *int64
+	SagaID       *int64
+	Reason       string
+	ErrorMessage string
+	RetryCount   int
+	CreatedAt    time.Time
+}
@@ -0,0 +1,73 @@
+package dbservice
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+// BackupURLSigner 백업 다운로드 주소에 HMAC 서명과 만료 시각을 붙임
+// 다운로드 핸들러는 세션 없이 서명만으로 요청을 허용
+type BackupURLSigner struct {
+	key     []byte
+	ttl     time.Duration
+	baseURL string // 비어 있으면 상대 경로
+}
+
+func NewBackupURLSigner(key string, ttl time.Duration, baseURL string, logger *log.Logger) (*BackupURLSigner, error) {
+	signingKey := []byte(key)
+	if len(signingKey) == 0 {
+		// 재시작하거나 레플리카가 여러 개면 발급한 링크가 무효가 되므로 운영에서는 키를 지정
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return nil, fmt.Errorf("백업 다운로드 서명 키 생성 실패: %w", err)
+		}
+		logger.Printf("WARNING: BACKUP_DOWNLOAD_SIGNING_KEY 가 없어 임시 키로 다운로드 링크를 서명합니다")
+	}
+
+	return &BackupURLSigner{
+		key:     signingKey,
+		ttl:     ttl,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+// Sign userID 에게 발급하는 backupID 다운로드 주소
+func (s *BackupURLSigner) Sign(backupID, userID string, now time.Time) *dbservice.BackupDownload {
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	expires := expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("uid", userID)
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", s.signature(backupID, userID, expires))
+
+	return &dbservice.BackupDownload{
+		URL:       fmt.Sprintf("%s/backups/%s/archive?%s", s.baseURL, url.PathEscape(backupID), query.Encode()),
+		ExpiresAt: expiresAt,
+	}
+}
+
+// Verify 서명이 맞고 만료되지 않았는지 확인
+func (s *BackupURLSigner) Verify(signed *dbservice.SignedBackupURL, now time.Time) bool {
+	if signed.Expires < now.Unix() || signed.Expires > now.Add(s.ttl).Unix() {
+		return false
+	}
+	expected := s.signature(signed.BackupID, signed.UserID, signed.Expires)
+	return hmac.Equal([]byte(expected), []byte(signed.Signature))
+}
+
+func (s *BackupURLSigner) signature(backupID, userID string, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%s\n%s\n%d", backupID, userID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,96 @@
+package mongodb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+var _ dbservice.ActivitySampler = (*engine)(nil)
+
+// activityScript opcounters 를 window 간격으로 두 번 읽고, 사용자 연결 수와 함께 한 줄 JSON 으로 출력
+// command 카운터는 헬스 체크와 이 스크립트의 serverStatus 도 올리므로 제외
+// TCP 헬스 체크는 clientMetadata 가 없고, 레플리카 멤버 간 연결은 NetworkInterfaceTL 드라이버로 구분
+// mongosh 는 모니터링 연결을 따로 열기 때문에 이 스크립트와 같은 주소에서 온 연결은 모두 제외
+const activityScript = `
+const windowMs = %d;
+const operations = function () {
+  const c = db.serverStatus().opcounters;
+  return Number(c.insert) + Number(c.query) + Number(c.update) + Number(c.delete) + Number(c.getmore);
+};
+const before = operations();
+sleep(windowMs);
+const after = operations();
+
+const host = function (client) { return client.replace(/:\d+$/, ""); };
+const ops = db.getSiblingDB("admin").aggregate([
+  {$currentOp: {allUsers: true, idleConnections: true, localOps: true}}
+]).toArray();
+const self = ops.find(function (o) {
+  return o.client && o.command && o.command.pipeline && o.command.pipeline.length > 0 &&
+    o.command.pipeline[0].$currentOp !== undefined;
+});
+const selfHost = self ? host(self.client) : "";
+const connections = ops.filter(function (o) {
+  if (!o.client || !o.clientMetadata) return false;
+  const driver = (o.clientMetadata.driver || {}).name || "";
+  if (driver.indexOf("NetworkInterface") === 0) return false;
+  return host(o.client) !== selfHost;
+}).length;
+
+print(JSON.stringify({connections: connections, operations: after - before}));
+`
+
+func (e *engine) ActivityCommand(window time.Duration) []string {
+	return []string{
+		"mongosh", "--quiet",
+		"--host", "$(DB_HOST)", "--port", "$(DB_PORT)",
+		"-u", "$(MONGO_INITDB_ROOT_USERNAME)", "-p", "$(MONGO_INITDB_ROOT_PASSWORD)",
+		"--authenticationDatabase", "admin",
+		"--eval", fmt.Sprintf(activityScript, window.Milliseconds()),
+	}
+}
+
+type activitySample struct {
+	Connections int     `json:"connections"`
+	Operations  float64 `json:"operations"`
+}
+
+// ParseActivity activityScript 의 마지막 JSON 줄을 읽음, mongosh 경고 등 다른 출력은 건너뜀
+func (e *engine) ParseActivity(output []byte, window time.Duration) (int, int, error) {
+	var sample *activitySample
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var s activitySample
+		if err := json.Unmarshal(line, &s); err != nil {
+			continue
+		}
+		sample = &s
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, fmt.Errorf("read activity output: %w", err)
+	}
+	if sample == nil {
+		return 0, 0, fmt.Errorf("activity output has no sample")
+	}
+
+	return sample.Connections, opsPerSecond(sample.Operations, window), nil
+}
+
+// opsPerSecond 작업이 한 번이라도 있으면 1 이상
+func opsPerSecond(operations float64, window time.Duration) int {
+	if operations <= 0 || window <= 0 {
+		return 0
+	}
+	return int(math.Ceil(operations / window.Seconds()))
+}
@@ -0,0 +1,12 @@
+package mongodb
+
+import (
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+var _ dbservice.Cloner = (*engine)(nil)
+
+// ValidateClone 라이브 복제는 mongodump | mongorestore, 백업은 mongorestore 로 적재
+func (e *engine) ValidateClone(_ *dbservice.DBInstance, _ bool) error {
+	return nil
+}
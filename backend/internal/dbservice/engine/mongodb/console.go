@@ -0,0 +1,182 @@
+package mongodb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+var _ dbservice.Console = (*engine)(nil)
+
+// consoleScript 요청은 환경 변수에서 EJSON 으로 읽어 스크립트에 끼워 넣지 않음
+// 결과는 한 줄 JSON: {"result": ..., "count": n, "truncated": bool} 또는 {"error": "..."}
+const consoleScript = `
+const maxBytes = %d, maxTimeMS = %d;
+try {
+  const req = EJSON.parse(process.env.%s);
+  const target = db.getSiblingDB(req.database || "test");
+  const coll = req.collection ? target.getCollection(req.collection) : null;
+  let cursor = null, result;
+  switch (req.command) {
+    case "find":
+      cursor = coll.find(req.filter || {}, req.projection || {}).limit(req.limit).maxTimeMS(maxTimeMS);
+      if (req.sort) cursor = cursor.sort(req.sort);
+      break;
+    case "aggregate":
+      cursor = coll.aggregate((req.pipeline || []).concat([{$limit: req.limit}]), {maxTimeMS: maxTimeMS});
+      break;
+    case "countDocuments":
+      result = coll.countDocuments(req.filter || {}, {maxTimeMS: maxTimeMS});
+      break;
+    case "listCollections":
+      result = target.getCollectionNames();
+      break;
+    case "listDatabases":
+      result = db.adminCommand({listDatabases: 1, nameOnly: true}).databases.map(function (d) { return d.name; });
+      break;
+  }
+  if (cursor) {
+    const docs = [];
+    let size = 0, truncated = false;
+    while (cursor.hasNext()) {
+      const doc = EJSON.stringify(cursor.next(), {relaxed: true});
+      if (size + doc.length > maxBytes) { truncated = true; break; }
+      size += doc.length;
+      docs.push(doc);
+    }
+    print('{"result":[' + docs.join(",") + '],"count":' + docs.length + ',"truncated":' + truncated + '}');
+  } else {
+    print(EJSON.stringify({result: result, truncated: false}, {relaxed: true}));
+  }
+} catch (e) {
+  print(JSON.stringify({error: e.message}));
+}
+`
+
+// consoleCommands 허용 명령과 컬렉션 필요 여부
+var consoleCommands = map[string]bool{
+	"find":            true,
+	"aggregate":       true,
+	"countDocuments":  true,
+	"listCollections": false,
+	"listDatabases":   false,
+}
+
+// forbiddenOperators 쓰기 단계와 서버 측 JS 실행 연산자
+var forbiddenOperators = map[string]bool{
+	"$out":         true,
+	"$merge":       true,
+	"$where":       true,
+	"$function":    true,
+	"$accumulator": true,
+}
+
+// systemDatabases 콘솔에서 조회하지 않는 내부 DB
+var systemDatabases = map[string]bool{
+	"admin":  true,
+	"local":  true,
+	"config": true,
+}
+
+func (e *engine) ConsoleCommand(req *dbservice.ConsoleRequest) ([]string, error) {
+	needsCollection, ok := consoleCommands[req.Command]
+	if !ok {
+		return nil, errors.NewInvalidParameterError("command",
+			"find, aggregate, countDocuments, listCollections, listDatabases 만 사용할 수 있습니다")
+	}
+
+	if req.Command != "listDatabases" {
+		if req.Database == "" {
+			return nil, errors.NewMissingParameterError("database")
+		}
+		if systemDatabases[req.Database] {
+			return nil, errors.NewInvalidParameterError("database", "시스템 DB 는 조회할 수 없습니다")
+		}
+	}
+	if needsCollection && req.Collection == "" {
+		return nil, errors.NewMissingParameterError("collection")
+	}
+
+	if op := findForbiddenOperator(req.Filter, req.Projection, req.Sort, req.Pipeline); op != "" {
+		return nil, errors.NewInvalidParameterError("command",
+			fmt.Sprintf("%s 연산자는 사용할 수 없습니다", op))
+	}
+
+	return []string{
+		"mongosh", "--quiet",
+		"--host", "$(DB_HOST)", "--port", "$(DB_PORT)",
+		"-u", "$(MONGO_INITDB_ROOT_USERNAME)", "-p", "$(MONGO_INITDB_ROOT_PASSWORD)",
+		"--authenticationDatabase", "admin",
+		"--eval", fmt.Sprintf(consoleScript, dbservice.MaxConsoleResultBytes,
+			dbservice.ConsoleTimeout.Milliseconds(), dbservice.ConsoleRequestEnv),
+	}, nil
+}
+
+type consoleOutput struct {
+	Result    json.RawMessage `json:"result"`
+	Count     *int            `json:"count"`
+	Truncated bool            `json:"truncated"`
+	Error     string          `json:"error"`
+}
+
+func (e *engine) ParseConsoleOutput(req *dbservice.ConsoleRequest, output []byte) (*dbservice.ConsoleResult, error) {
+	// 마지막 JSON 줄이 결과 (앞에 경고가 섞일 수 있음)
+	var line []byte
+	for _, l := range bytes.Split(bytes.TrimSpace(output), []byte("\n")) {
+		if l = bytes.TrimSpace(l); len(l) > 0 && l[0] == '{' {
+			line = l
+		}
+	}
+
+	var out consoleOutput
+	if line == nil || json.Unmarshal(line, &out) != nil {
+		return nil, errors.NewCommandFailedError(strings.TrimSpace(string(output)))
+	}
+	if out.Error != "" {
+		return nil, errors.NewCommandFailedError(out.Error)
+	}
+	if out.Result == nil {
+		out.Result = json.RawMessage("null")
+	}
+
+	return &dbservice.ConsoleResult{
+		Command:   req.Command,
+		Result:    out.Result,
+		Count:     out.Count,
+		Truncated: out.Truncated,
+	}, nil
+}
+
+// findForbiddenOperator 중첩된 파이프라인($lookup, $facet 등)까지 확인
+func findForbiddenOperator(values ...interface{}) string {
+	for _, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				if forbiddenOperators[key] {
+					return key
+				}
+				if op := findForbiddenOperator(child); op != "" {
+					return op
+				}
+			}
+		case []map[string]interface{}:
+			for _, child := range v {
+				if op := findForbiddenOperator(child); op != "" {
+					return op
+				}
+			}
+		case []interface{}:
+			for _, child := range v {
+				if op := findForbiddenOperator(child); op != "" {
+					return op
+				}
+			}
+		}
+	}
+	return ""
+}
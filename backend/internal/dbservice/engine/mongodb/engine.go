@@ -0,0 +1,138 @@
+package mongodb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/platform/validation"
+)
+
+const (
+	defaultPort    = 27017
+	defaultVersion = "7.0"
+)
+
+type Config struct {
+	Version         string `json:"version" validate:"required,oneof=6.0 7.0"`
+	WiredTigerCache *int32 `json:"wiredTigerCache,omitempty" validate:"omitempty,min=1"`
+	ReplicaCount    *int32 `json:"replicaCount,omitempty" validate:"omitempty,oneof=3 5 7"`
+	ShardCount      *int32 `json:"shardCount,omitempty" validate:"omitempty,min=2,max=10"`
+}
+
+type engine struct{}
+
+var _ dbservice.Engine = (*engine)(nil)
+
+func init() {
+	dbservice.RegisterEngine(&engine{})
+}
+
+func (e *engine) Type() dbservice.DBType {
+	return dbservice.MongoDB
+}
+
+func (e *engine) Available() error {
+	return nil
+}
+
+func (e *engine) Modes() []dbservice.DBMode {
+	return []dbservice.DBMode{
+		dbservice.ModeStandalone,
+		dbservice.ModeReplicaSet,
+		dbservice.ModeSharded,
+	}
+}
+
+func (e *engine) DefaultPort() int {
+	return defaultPort
+}
+
+func (e *engine) DefaultConfig(_ dbservice.DBMode) map[string]interface{} {
+	// Mode별 기본값은 MongoDB Operator가 처리
+	return map[string]interface{}{
+		"version": defaultVersion,
+	}
+}
+
+func (e *engine) ValidateConfig(mode dbservice.DBMode, rawConfig map[string]interface{}, resources *dbservice.ResourceSpec) error {
+	jsonBytes, err := json.Marshal(rawConfig)
+	if err != nil {
+		return errors.NewInvalidParameterError("config", "올바른 JSON 형식이 아닙니다")
+	}
+
+	var config Config
+	if err := json.Unmarshal(jsonBytes, &config); err != nil {
+		return errors.NewInvalidParameterError("config", "MongoDB 설정 구조가 올바르지 않습니다")
+	}
+
+	// 구조체 validation
+	if err := validation.ValidateStruct(&config); err != nil {
+		return err
+	}
+
+	// Mode별 필드 검증
+	switch mode {
+	case dbservice.ModeStandalone:
+		if config.ReplicaCount != nil {
+			return errors.NewInvalidParameterError("config.replicaCount",
+				"standalone 모드에서는 replicaCount를 설정할 수 없습니다")
+		}
+		if config.ShardCount != nil {
+			return errors.NewInvalidParameterError("config.shardCount",
+				"standalone 모드에서는 shardCount를 설정할 수 없습니다")
+		}
+
+	case dbservice.ModeReplicaSet:
+		if config.ShardCount != nil {
+			return errors.NewInvalidParameterError("config.shardCount",
+				"replica set 모드에서는 shardCount를 설정할 수 없습니다")
+		}
+		// replicaCount는 설정 가능 (기본값 3)
+
+	case dbservice.ModeSharded:
+		if config.ReplicaCount != nil {
+			return errors.NewInvalidParameterError("config.replicaCount",
+				"sharded 모드에서는 replicaCount를 설정할 수 없습니다")
+		}
+		// shardCount는 설정 가능 (기본값 2)
+	}
+
+	// WiredTigerCache 검증 (메모리의 50% 이하)
+	if config.WiredTigerCache != nil && resources != nil {
+		maxCache := int32(resources.Memory) / 2 / 1024 // MB to GB
+		if maxCache < 1 {
+			maxCache = 1
+		}
+		if *config.WiredTigerCache > maxCache {
+			return errors.NewInvalidParameterError("config.wiredTigerCache",
+				"wiredTigerCache는 할당된 메모리의 50% 이하여야 합니다")
+		}
+	}
+
+	return nil
+}
+
+func (e *engine) MemoryCost(memoryMB int) float64 {
+	return float64(memoryMB) / 1024 * 3 // 1GB당 3레몬
+}
+
+func (e *engine) SecretData(username, password string) map[string][]byte {
+	return map[string][]byte{
+		"username":                   []byte(username),
+		"password":                   []byte(password),
+		"MONGO_INITDB_ROOT_USERNAME": []byte(username),
+		"MONGO_INITDB_ROOT_PASSWORD": []byte(password),
+		"MONGO_INITDB_DATABASE":      []byte("admin"),
+	}
+}
+
+func (e *engine) ConnectionURI(username, password, host string, port int, database string, tls bool) string {
+	uri := fmt.Sprintf("mongodb://%s:%s@%s:%d/%s?authSource=admin",
+		username, password, host, port, database)
+	if tls {
+		uri += "&tls=true"
+	}
+	return uri
+}
@@ -0,0 +1,32 @@
+package mongodb
+
+import (
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+var _ dbservice.Importer = (*engine)(nil)
+
+func (e *engine) ValidateImport(req *dbservice.StartImportRequest) error {
+	switch req.Format {
+	case dbservice.ImportFormatMongodump:
+		// 아카이브에 DB/컬렉션 이름이 들어 있음
+		if req.Database != "" || req.Collection != "" {
+			return errors.NewInvalidParameterError("database", "mongodump 아카이브는 대상 DB 를 지정하지 않습니다")
+		}
+		return nil
+	case dbservice.ImportFormatJSON, dbservice.ImportFormatCSV:
+		if req.Database == "" {
+			return errors.NewMissingParameterError("database")
+		}
+		if req.Collection == "" {
+			return errors.NewMissingParameterError("collection")
+		}
+		if systemDatabases[req.Database] {
+			return errors.NewInvalidParameterError("database", "시스템 DB 에는 가져올 수 없습니다")
+		}
+		return nil
+	default:
+		return errors.NewInvalidParameterError("format", "mongodump, json, csv 만 사용할 수 있습니다")
+	}
+}
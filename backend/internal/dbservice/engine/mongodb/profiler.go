@@ -0,0 +1,249 @@
+package mongodb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+const clientImage = "mongo:7.0"
+
+var _ dbservice.Profiler = (*engine)(nil)
+
+// slowLogScript 사용자 DB 마다 system.profile 최근 항목을 한 줄에 하나씩 EJSON 으로 출력
+const slowLogScript = `
+const limit = %d;
+db.adminCommand({listDatabases: 1, nameOnly: true}).databases.forEach(function (d) {
+  if (["admin", "local", "config"].includes(d.name)) return;
+  db.getSiblingDB(d.name).system.profile
+    .find({}, {ts: 1, ns: 1, op: 1, command: 1, millis: 1, planSummary: 1})
+    .sort({ts: -1}).limit(limit)
+    .forEach(function (p) { print(EJSON.stringify(p, {relaxed: true})); });
+});
+`
+
+func (e *engine) ClientImage() string {
+	return clientImage
+}
+
+func (e *engine) SlowLogCommand(limit int) []string {
+	return []string{
+		"mongosh", "--quiet",
+		"--host", "$(DB_HOST)", "--port", "$(DB_PORT)",
+		"-u", "$(MONGO_INITDB_ROOT_USERNAME)", "-p", "$(MONGO_INITDB_ROOT_PASSWORD)",
+		"--authenticationDatabase", "admin",
+		"--eval", fmt.Sprintf(slowLogScript, limit),
+	}
+}
+
+// profileEntry system.profile 문서 중 사용하는 필드
+type profileEntry struct {
+	TS struct {
+		Date time.Time `json:"$date"`
+	} `json:"ts"`
+	NS          string                 `json:"ns"`
+	Op          string                 `json:"op"`
+	Command     map[string]interface{} `json:"command"`
+	Millis      float64                `json:"millis"`
+	PlanSummary string                 `json:"planSummary"`
+}
+
+func (e *engine) ParseSlowLog(output []byte) ([]*dbservice.SlowOperation, error) {
+	var ops []*dbservice.SlowOperation
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+
+		var entry profileEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+
+		q := queryOf(entry.Op, entry.Command)
+		op := &dbservice.SlowOperation{
+			Time:      entry.TS.Date,
+			Namespace: entry.NS,
+			Shape:     entry.Op + " " + shapeOf(q),
+			Duration:  time.Duration(entry.Millis * float64(time.Millisecond)),
+		}
+		if strings.HasPrefix(entry.PlanSummary, "COLLSCAN") {
+			op.IndexSuggestion = suggestIndex(q)
+		}
+		ops = append(ops, op)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read profile output: %w", err)
+	}
+
+	return ops, nil
+}
+
+// query 필터와 정렬 조건
+type query struct {
+	filter map[string]interface{}
+	sort   map[string]interface{}
+}
+
+// queryOf 명령 종류별로 필터 위치가 달라 한 형태로 모음
+func queryOf(op string, command map[string]interface{}) query {
+	var q query
+	switch {
+	case command["find"] != nil:
+		q.filter, _ = command["filter"].(map[string]interface{})
+		q.sort, _ = command["sort"].(map[string]interface{})
+	case command["aggregate"] != nil:
+		// 첫 $match / $sort 단계만 인덱스 사용 가능
+		pipeline, _ := command["pipeline"].([]interface{})
+		for _, raw := range pipeline {
+			stage, _ := raw.(map[string]interface{})
+			if match, ok := stage["$match"].(map[string]interface{}); ok && q.filter == nil {
+				q.filter = match
+				continue
+			}
+			if s, ok := stage["$sort"].(map[string]interface{}); ok && q.sort == nil {
+				q.sort = s
+				continue
+			}
+			break
+		}
+	case command["count"] != nil, command["distinct"] != nil:
+		q.filter, _ = command["query"].(map[string]interface{})
+	case op == "update" || op == "remove":
+		q.filter, _ = command["q"].(map[string]interface{})
+	default:
+		q.filter, _ = command["filter"].(map[string]interface{})
+	}
+	return q
+}
+
+// shapeOf 값은 ? 로 바꾸고 키를 정렬해 같은 형태의 쿼리를 하나로 묶음
+func shapeOf(q query) string {
+	shape := map[string]interface{}{}
+	if q.filter != nil {
+		shape["filter"] = normalizeValue(q.filter)
+	}
+	if q.sort != nil {
+		// 정렬 방향은 형태의 일부
+		shape["sort"] = q.sort
+	}
+	data, _ := json.Marshal(shape) // map 키는 정렬되어 출력됨
+	return string(data)
+}
+
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// EJSON 타입 래퍼($oid, $date 등)는 값 하나로 취급
+		if len(v) == 1 {
+			for key := range v {
+				if ejsonTypes[key] {
+					return "?"
+				}
+			}
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			result[key] = normalizeValue(child)
+		}
+		return result
+	case []interface{}:
+		// $and/$or 배열은 구조 유지, 값 배열($in 등)은 하나로
+		if len(v) > 0 {
+			if _, ok := v[0].(map[string]interface{}); ok {
+				result := make([]interface{}, len(v))
+				for i, child := range v {
+					result[i] = normalizeValue(child)
+				}
+				return result
+			}
+		}
+		return "?"
+	default:
+		return "?"
+	}
+}
+
+var ejsonTypes = map[string]bool{
+	"$oid": true, "$date": true, "$numberLong": true, "$numberDecimal": true,
+	"$numberInt": true, "$numberDouble": true, "$binary": true, "$regularExpression": true,
+	"$timestamp": true, "$uuid": true,
+}
+
+// rangeOperators 범위 조건, ESR 규칙에서 마지막에 배치
+var rangeOperators = map[string]bool{
+	"$gt": true, "$gte": true, "$lt": true, "$lte": true,
+	"$ne": true, "$nin": true, "$regex": true, "$exists": true,
+}
+
+// suggestIndex ESR(Equality, Sort, Range) 규칙으로 복합 인덱스 제안
+func suggestIndex(q query) string {
+	var equality, ranges []string
+	for field, cond := range q.filter {
+		if strings.HasPrefix(field, "$") {
+			// $or/$and/$expr 등은 단순 제안 대상 아님
+			continue
+		}
+		if isRange(cond) {
+			ranges = append(ranges, field)
+		} else {
+			equality = append(equality, field)
+		}
+	}
+	sort.Strings(equality)
+	sort.Strings(ranges)
+
+	var sortFields []string
+	for field := range q.sort {
+		sortFields = append(sortFields, field)
+	}
+	// sort 문서의 키 순서는 JSON 디코딩 시 유지되지 않아 이름순으로 정렬
+	sort.Strings(sortFields)
+
+	var keys []string
+	seen := map[string]bool{}
+	add := func(field string, direction interface{}) {
+		if seen[field] {
+			return
+		}
+		seen[field] = true
+		keys = append(keys, fmt.Sprintf("%q: %v", field, direction))
+	}
+	for _, field := range equality {
+		add(field, 1)
+	}
+	for _, field := range sortFields {
+		add(field, q.sort[field])
+	}
+	for _, field := range ranges {
+		add(field, 1)
+	}
+
+	if len(keys) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(keys, ", ") + "}"
+}
+
+func isRange(cond interface{}) bool {
+	m, ok := cond.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for op := range m {
+		if rangeOperators[op] {
+			return true
+		}
+	}
+	return false
+}
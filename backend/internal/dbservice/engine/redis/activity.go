@@ -0,0 +1,136 @@
+package redis
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+var _ dbservice.ActivitySampler = (*engine)(nil)
+
+// activityScript INFO commandstats 를 window 간격으로 두 번 출력한 뒤 CLIENT LIST 출력
+// redis-cli 호출마다 연결이 새로 열리므로 마지막 CLIENT LIST 연결만 자기 자신
+const activityScript = `cli() { redis-cli --no-auth-warning -h "$DB_HOST" -p "$DB_PORT" -a "$REDIS_PASSWORD" "$@"; }
+cli INFO commandstats && sleep %d && cli INFO commandstats && echo "` + clientListHeader + `" && cli CLIENT LIST`
+
+const (
+	commandStatsHeader = "# Commandstats"
+	clientListHeader   = "# Clientlist"
+)
+
+func (e *engine) ActivityCommand(window time.Duration) []string {
+	seconds := int(math.Ceil(window.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return []string{"sh", "-c", fmt.Sprintf(activityScript, seconds)}
+}
+
+// adminCommands 헬스 체크, 모니터링, 조회 자신이 보내는 명령이라 사용량에서 제외
+var adminCommands = map[string]bool{
+	"ping":    true,
+	"info":    true,
+	"auth":    true,
+	"hello":   true,
+	"quit":    true,
+	"client":  true,
+	"command": true,
+	"config":  true,
+	"slowlog": true,
+}
+
+func (e *engine) ParseActivity(output []byte, window time.Duration) (int, int, error) {
+	var stats []map[string]int64
+	var clients []string
+	inClients := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+		case line == commandStatsHeader:
+			stats = append(stats, map[string]int64{})
+			inClients = false
+		case line == clientListHeader:
+			inClients = true
+		case inClients:
+			clients = append(clients, line)
+		case len(stats) > 0 && strings.HasPrefix(line, "cmdstat_"):
+			name, calls, ok := parseCommandStat(line)
+			if ok && !adminCommands[strings.SplitN(name, "|", 2)[0]] {
+				stats[len(stats)-1][name] = calls
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, fmt.Errorf("read activity output: %w", err)
+	}
+	if len(stats) != 2 || !inClients {
+		return 0, 0, fmt.Errorf("activity output is incomplete")
+	}
+
+	var operations int64
+	for name, calls := range stats[1] {
+		operations += calls - stats[0][name]
+	}
+
+	connections := 0
+	for _, line := range clients {
+		if isUserClient(parseClientFields(line)) {
+			connections++
+		}
+	}
+
+	ops := 0
+	if operations > 0 && window > 0 {
+		ops = int(math.Ceil(float64(operations) / window.Seconds()))
+	}
+	return connections, ops, nil
+}
+
+// parseCommandStat cmdstat_get:calls=12,usec=30,usec_per_call=2.50,rejected_calls=0,failed_calls=0
+func parseCommandStat(line string) (string, int64, bool) {
+	name, values, ok := strings.Cut(strings.TrimPrefix(line, "cmdstat_"), ":")
+	if !ok {
+		return "", 0, false
+	}
+	for _, field := range strings.Split(values, ",") {
+		key, value, _ := strings.Cut(field, "=")
+		if key == "calls" {
+			calls, err := strconv.ParseInt(value, 10, 64)
+			return name, calls, err == nil
+		}
+	}
+	return "", 0, false
+}
+
+// parseClientFields CLIENT LIST 한 줄 (id=3 addr=10.0.0.5:41234 ... flags=N ... cmd=get ...)
+func parseClientFields(line string) map[string]string {
+	fields := make(map[string]string)
+	for _, part := range strings.Fields(line) {
+		if key, value, ok := strings.Cut(part, "="); ok {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// isUserClient 조회 연결 자신, 파드 안에서 실행되는 헬스 체크, 레플리카 연결 제외
+func isUserClient(fields map[string]string) bool {
+	if fields["cmd"] == "client|list" {
+		return false
+	}
+	addr := fields["addr"]
+	if strings.HasPrefix(addr, "127.0.0.1:") || strings.HasPrefix(addr, "::1:") || strings.HasPrefix(addr, "/") {
+		return false
+	}
+	return !strings.ContainsAny(fields["flags"], "SM")
+}
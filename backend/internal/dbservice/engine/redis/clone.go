@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+var _ dbservice.Cloner = (*engine)(nil)
+
+func (e *engine) ValidateClone(source *dbservice.DBInstance, fromBackup bool) error {
+	if fromBackup {
+		// RDB 백업은 서버 시작 시에만 로드되어 복원 Job 으로 적재할 수 없음
+		return errors.NewInvalidParameterError("sourceBackupId",
+			"Redis 는 백업에서 복제할 수 없습니다. sourceInstanceId 로 실행 중인 인스턴스를 복제하세요")
+	}
+	if source.Mode == dbservice.ModeCluster {
+		// MIGRATE 로 키를 옮기므로 한 노드에 모든 키가 있어야 함
+		return errors.NewInvalidParameterError("sourceInstanceId", "cluster 모드 인스턴스는 복제할 수 없습니다")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package redis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+var _ dbservice.Console = (*engine)(nil)
+
+// consoleCommand 허용 명령의 인자 개수 범위, max 가 -1 이면 제한 없음
+type consoleCommand struct {
+	min, max int
+}
+
+var consoleCommands = map[string]consoleCommand{
+	"GET":      {1, 1},
+	"MGET":     {1, -1},
+	"STRLEN":   {1, 1},
+	"TYPE":     {1, 1},
+	"TTL":      {1, 1},
+	"PTTL":     {1, 1},
+	"EXISTS":   {1, -1},
+	"HGET":     {2, 2},
+	"HGETALL":  {1, 1},
+	"HLEN":     {1, 1},
+	"HKEYS":    {1, 1},
+	"LLEN":     {1, 1},
+	"LRANGE":   {3, 3},
+	"SCARD":    {1, 1},
+	"SMEMBERS": {1, 1},
+	"ZCARD":    {1, 1},
+	"ZRANGE":   {3, 4}, // WITHSCORES
+	"SCAN":     {1, 7}, // cursor [MATCH pattern] [COUNT n] [TYPE type]
+	"HSCAN":    {2, 6},
+	"SSCAN":    {2, 6},
+	"ZSCAN":    {2, 6},
+	"DBSIZE":   {0, 0},
+}
+
+func (e *engine) ConsoleCommand(req *dbservice.ConsoleRequest) ([]string, error) {
+	name := strings.ToUpper(req.Command)
+	spec, ok := consoleCommands[name]
+	if !ok {
+		return nil, errors.NewInvalidParameterError("command",
+			fmt.Sprintf("%s 는 콘솔에서 사용할 수 없는 명령입니다", req.Command))
+	}
+	if len(req.Args) < spec.min || (spec.max >= 0 && len(req.Args) > spec.max) {
+		return nil, errors.NewInvalidParameterError("args",
+			fmt.Sprintf("%s 인자 개수가 올바르지 않습니다", name))
+	}
+
+	if err := limitRange(name, req.Args, req.Limit); err != nil {
+		return nil, err
+	}
+
+	// exec 로 실행되므로 인자가 셸에서 해석되지 않음
+	command := []string{
+		"redis-cli", "--no-auth-warning", "--json",
+		"-h", "$(DB_HOST)", "-p", "$(DB_PORT)", "-a", "$(REDIS_PASSWORD)",
+		name,
+	}
+	return append(command, escapeArgs(req.Args)...), nil
+}
+
+// limitRange LRANGE/ZRANGE 범위와 SCAN COUNT 를 limit 이하로 제한
+func limitRange(name string, args []string, limit int) error {
+	switch name {
+	case "LRANGE", "ZRANGE":
+		start, err1 := strconv.Atoi(args[1])
+		stop, err2 := strconv.Atoi(args[2])
+		if err1 != nil || err2 != nil || start < 0 || stop < start {
+			return errors.NewInvalidParameterError("args", "start, stop 은 0 이상의 정수여야 합니다")
+		}
+		if stop-start+1 > limit {
+			return errors.NewInvalidParameterError("args",
+				fmt.Sprintf("한 번에 최대 %d개까지 조회할 수 있습니다", limit))
+		}
+	case "SCAN", "HSCAN", "SSCAN", "ZSCAN":
+		for i, arg := range args {
+			if strings.EqualFold(arg, "COUNT") && i+1 < len(args) {
+				count, err := strconv.Atoi(args[i+1])
+				if err != nil || count < 1 || count > limit {
+					return errors.NewInvalidParameterError("args",
+						fmt.Sprintf("COUNT 는 1~%d 사이여야 합니다", limit))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// escapeArgs Kubernetes 가 $(VAR) 를 환경 변수로 치환하지 않도록 $ 를 $$ 로 이스케이프
+func escapeArgs(args []string) []string {
+	escaped := make([]string, len(args))
+	for i, arg := range args {
+		escaped[i] = strings.ReplaceAll(arg, "$", "$$")
+	}
+	return escaped
+}
+
+func (e *engine) ParseConsoleOutput(req *dbservice.ConsoleRequest, output []byte) (*dbservice.ConsoleResult, error) {
+	output = bytes.TrimSpace(output)
+	if bytes.HasPrefix(output, []byte("(error)")) {
+		return nil, errors.NewCommandFailedError(strings.TrimSpace(strings.TrimPrefix(string(output), "(error)")))
+	}
+	if !json.Valid(output) {
+		return nil, errors.NewCommandFailedError(string(output))
+	}
+
+	return &dbservice.ConsoleResult{
+		Command: strings.ToUpper(req.Command),
+		Result:  json.RawMessage(output),
+	}, nil
+}
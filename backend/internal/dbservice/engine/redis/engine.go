@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"fmt"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+const defaultPort = 6379
+
+type engine struct{}
+
+var _ dbservice.Engine = (*engine)(nil)
+
+func init() {
+	dbservice.RegisterEngine(&engine{})
+}
+
+func (e *engine) Type() dbservice.DBType {
+	return dbservice.Redis
+}
+
+func (e *engine) Available() error {
+	// TODO: Redis 추가
+	return errors.NewInvalidParameterError("type", "Redis는 아직 지원하지 않습니다")
+}
+
+func (e *engine) Modes() []dbservice.DBMode {
+	return []dbservice.DBMode{
+		dbservice.ModeBasic,
+		dbservice.ModeSentinel,
+		dbservice.ModeCluster,
+	}
+}
+
+func (e *engine) DefaultPort() int {
+	return defaultPort
+}
+
+func (e *engine) DefaultConfig(_ dbservice.DBMode) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (e *engine) ValidateConfig(_ dbservice.DBMode, _ map[string]interface{}, _ *dbservice.ResourceSpec) error {
+	return e.Available()
+}
+
+func (e *engine) MemoryCost(memoryMB int) float64 {
+	return float64(memoryMB) / 512 // 512MB당 1레몬
+}
+
+func (e *engine) SecretData(_, password string) map[string][]byte {
+	// Redis는 사용자명 없이 비밀번호만 사용
+	return map[string][]byte{
+		"password":       []byte(password),
+		"REDIS_PASSWORD": []byte(password),
+	}
+}
+
+func (e *engine) ConnectionURI(_, password, host string, port int, _ string, tls bool) string {
+	scheme := "redis"
+	if tls {
+		scheme = "rediss"
+	}
+	return fmt.Sprintf("%s://:%s@%s:%d", scheme, password, host, port)
+}
@@ -0,0 +1,24 @@
+package redis
+
+import (
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+var _ dbservice.Importer = (*engine)(nil)
+
+func (e *engine) ValidateImport(req *dbservice.StartImportRequest) error {
+	switch req.Format {
+	case dbservice.ImportFormatRESP:
+		if req.Database != "" || req.Collection != "" {
+			return errors.NewInvalidParameterError("database", "Redis 는 대상 DB 를 지정하지 않습니다")
+		}
+		return nil
+	case dbservice.ImportFormatRDB:
+		// RDB 는 서버 시작 시에만 로드되어 운영 중인 인스턴스에 적용할 수 없음
+		return errors.NewInvalidParameterError("format",
+			"RDB 파일은 실행 중인 인스턴스에 적재할 수 없습니다. redis-cli --pipe 용 RESP 형식으로 변환해 업로드하세요")
+	default:
+		return errors.NewInvalidParameterError("format", "resp 만 사용할 수 있습니다")
+	}
+}
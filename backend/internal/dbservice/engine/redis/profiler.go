@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+const clientImage = "redis:7.2"
+
+var _ dbservice.Profiler = (*engine)(nil)
+
+func (e *engine) ClientImage() string {
+	return clientImage
+}
+
+func (e *engine) SlowLogCommand(limit int) []string {
+	return []string{
+		"redis-cli", "--no-auth-warning", "--json",
+		"-h", "$(DB_HOST)", "-p", "$(DB_PORT)", "-a", "$(REDIS_PASSWORD)",
+		"SLOWLOG", "GET", strconv.Itoa(limit),
+	}
+}
+
+// ParseSlowLog SLOWLOG GET 결과 [id, timestamp, 소요(µs), [command, args...], client, name]
+func (e *engine) ParseSlowLog(output []byte) ([]*dbservice.SlowOperation, error) {
+	var entries [][]json.RawMessage
+	if err := json.Unmarshal(output, &entries); err != nil {
+		return nil, fmt.Errorf("parse slowlog output: %w", err)
+	}
+
+	ops := make([]*dbservice.SlowOperation, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry) < 4 {
+			continue
+		}
+
+		var timestamp, micros int64
+		var args []string
+		if json.Unmarshal(entry[1], &timestamp) != nil ||
+			json.Unmarshal(entry[2], &micros) != nil ||
+			json.Unmarshal(entry[3], &args) != nil || len(args) == 0 {
+			continue
+		}
+
+		ops = append(ops, &dbservice.SlowOperation{
+			Time:     time.Unix(timestamp, 0),
+			Shape:    commandShape(args),
+			Duration: time.Duration(micros) * time.Microsecond,
+		})
+	}
+
+	return ops, nil
+}
+
+var digits = regexp.MustCompile(`[0-9]+`)
+
+// commandShape 명령 이름과 키 패턴만 남김 (user:1001 → user:*), 나머지 인자는 ?
+func commandShape(args []string) string {
+	parts := []string{strings.ToUpper(args[0])}
+	if len(args) > 1 {
+		parts = append(parts, digits.ReplaceAllString(args[1], "*"))
+	}
+	if len(args) > 2 {
+		parts = append(parts, "?")
+	}
+	return strings.Join(parts, " ")
+}
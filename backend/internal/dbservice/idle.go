@@ -0,0 +1,207 @@
+package dbservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+func (s *service) UpdateIdlePolicy(ctx context.Context, userID, instanceID string, req *dbservice.UpdateIdlePolicyRequest) (*dbservice.IdlePolicy, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	policy := &dbservice.IdlePolicy{
+		Enabled:   req.Enabled,
+		IdleHours: req.IdleHours,
+	}
+	if err := s.dbiStore.UpdateIdlePolicy(ctx, instance.ID, policy); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 유휴 자동 중지 변경: enabled=%t idleHours=%d",
+		instance.ExternalID, policy.Enabled, policy.IdleHours)
+	return policy, nil
+}
+
+// PauseIdleInstances 인스턴스 사용량으로 유휴 시작 시각을 기록하고, 정책 시간이 지나면 중지
+// 사용량은 주기적으로 관측한 값이라 관측 사이의 짧은 사용은 놓칠 수 있음
+func (s *service) PauseIdleInstances(ctx context.Context) ([]*dbservice.DBInstance, error) {
+	instances, err := s.dbiStore.ListIdlePolicyEnabled(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	samples := s.sampleActivities(ctx, instances)
+
+	now := time.Now()
+	var paused []*dbservice.DBInstance
+	for i, instance := range instances {
+		metrics := samples[i]
+		if metrics == nil {
+			continue
+		}
+		idle, ok := metrics.IsIdle(now)
+		if !ok {
+			continue
+		}
+
+		if !idle {
+			if instance.IdleSince != nil {
+				if err := s.dbiStore.UpdateIdleSince(ctx, instance.ID, nil); err != nil {
+					s.logger.Printf("인스턴스 %s 유휴 시각 초기화 실패: %v", instance.ExternalID, err)
+				}
+			}
+			continue
+		}
+
+		if instance.IdleSince == nil {
+			since := metrics.Timestamp
+			if err := s.dbiStore.UpdateIdleSince(ctx, instance.ID, &since); err != nil {
+				s.logger.Printf("인스턴스 %s 유휴 시각 기록 실패: %v", instance.ExternalID, err)
+			}
+			continue
+		}
+
+		idleFor := now.Sub(*instance.IdleSince)
+		if idleFor < time.Duration(instance.IdlePolicy.IdleHours)*time.Hour {
+			continue
+		}
+
+		if err := s.pauseIdleInstance(ctx, instance); err != nil {
+			s.logger.Printf("인스턴스 %s 유휴 자동 중지 실패: %v", instance.ExternalID, err)
+			continue
+		}
+		s.logger.Printf("인스턴스 %s 유휴 자동 중지 (%v 동안 사용 없음)", instance.ExternalID, idleFor.Round(time.Minute))
+		paused = append(paused, instance)
+	}
+
+	return paused, nil
+}
+
+// idleSampleWorkers 동시에 띄우는 사용량 조회 파드 수
+const idleSampleWorkers = 4
+
+// sampleActivities 인스턴스마다 사용량 조회, 실패하거나 지원하지 않는 엔진은 nil
+// 오퍼레이터가 보고한 메트릭이 아직 유효하면 그대로 사용
+func (s *service) sampleActivities(ctx context.Context, instances []*dbservice.DBInstance) []*dbservice.InstanceMetrics {
+	samples := make([]*dbservice.InstanceMetrics, len(instances))
+	slots := make(chan struct{}, idleSampleWorkers)
+	var wg sync.WaitGroup
+
+	for i, instance := range instances {
+		if _, ok := instance.Metrics.IsIdle(time.Now()); ok {
+			samples[i] = instance.Metrics
+			continue
+		}
+
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, instance *dbservice.DBInstance) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			metrics, err := s.sampleActivity(ctx, instance)
+			if err != nil {
+				s.logger.Printf("인스턴스 %s 사용량 조회 실패: %v", instance.ExternalID, err)
+				return
+			}
+			samples[i] = metrics
+		}(i, instance)
+	}
+
+	wg.Wait()
+	return samples
+}
+
+// sampleActivity 인스턴스 네임스페이스의 클라이언트 파드로 연결 수와 초당 작업 수 조회
+func (s *service) sampleActivity(ctx context.Context, instance *dbservice.DBInstance) (*dbservice.InstanceMetrics, error) {
+	if instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+	engine, ok := dbservice.LookupEngine(instance.Type)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", instance.Type))
+	}
+	sampler, ok := engine.(dbservice.ActivitySampler)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("%s 는 사용량 조회를 지원하지 않습니다", instance.Type))
+	}
+
+	output, err := s.runClientCommand(ctx, instance, engine, "idle",
+		sampler.ActivityCommand(dbservice.IdleSampleWindow))
+	if err != nil {
+		return nil, err
+	}
+	connections, opsPerSecond, err := sampler.ParseActivity(output, dbservice.IdleSampleWindow)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	instanceID, _ := uuid.Parse(instance.ExternalID)
+	return &dbservice.InstanceMetrics{
+		InstanceID:          instanceID,
+		Connections:         connections,
+		OperationsPerSecond: opsPerSecond,
+		Timestamp:           time.Now(),
+	}, nil
+}
+
+func (s *service) pauseIdleInstance(ctx context.Context, instance *dbservice.DBInstance) error {
+	if !instance.CanStop() {
+		return errors.NewInvalidStatusTransitionError(string(instance.Status), string(dbservice.StatusStopped))
+	}
+
+	if err := s.dbiStore.UpdateStatus(ctx, instance.ID, dbservice.StatusStopped, dbservice.IdlePausedReason); err != nil {
+		return errors.Wrap(err)
+	}
+
+	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
+		if err := s.k8sClient.PatchDBInstanceStatus(
+			ctx,
+			instance.K8sNamespace,
+			instance.K8sResourceName,
+			string(dbservice.StatusStopped),
+			// 상태 동기화가 status_reason 을 CRD 값으로 덮어쓰므로 같은 표식을 보냄
+			dbservice.IdlePausedReason,
+		); err != nil {
+			// 롤백
+			_ = s.dbiStore.UpdateStatus(ctx, instance.ID, instance.Status, "K8s update failed")
+			return errors.Wrap(err)
+		}
+	}
+
+	if err := s.dbiStore.UpdateIdleSince(ctx, instance.ID, nil); err != nil {
+		s.logger.Printf("인스턴스 %s 유휴 시각 초기화 실패: %v", instance.ExternalID, err)
+	}
+
+	instance.Status = dbservice.StatusStopped
+	instance.StatusReason = dbservice.IdlePausedReason
+	return nil
+}
+
+// ResumeInstance 유휴 자동 중지 알림 메일의 재개 버튼에서 호출, 시작과 같이 바로 과금
+func (s *service) ResumeInstance(ctx context.Context, userID, instanceID string) error {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	if !instance.IsIdlePaused() {
+		return errors.NewInvalidStatusTransitionError(string(instance.Status), string(dbservice.StatusRunning))
+	}
+
+	return s.StartInstance(ctx, userID, instanceID)
+}
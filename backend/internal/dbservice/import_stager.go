@@ -0,0 +1,93 @@
+package dbservice
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// ImportStager 업로드된 가져오기 파일을 import Job 이 내려받을 때까지 보관
+// 파일은 import 가 끝나면 StatusSyncer 가 지움
+type ImportStager struct {
+	dir     string
+	baseURL string // 클러스터 안에서 백엔드에 접근하는 주소
+}
+
+func NewImportStager(dir, baseURL string) (*ImportStager, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("가져오기 임시 디렉터리 생성 실패: %w", err)
+	}
+	return &ImportStager{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+func (s *ImportStager) path(importID string) string {
+	// importID 는 서버에서 만든 UUID 라 경로 조작 불가
+	return filepath.Join(s.dir, importID)
+}
+
+// Save body 를 최대 limit 바이트까지 저장, 초과하면 파일을 지우고 ImportTooLarge 반환
+func (s *ImportStager) Save(importID string, body io.Reader, limit int64) (int64, error) {
+	tmp, err := os.CreateTemp(s.dir, importID+".*.part")
+	if err != nil {
+		return 0, errors.Wrapf(err, "가져오기 임시 파일 생성 실패")
+	}
+	defer os.Remove(tmp.Name()) // rename 후에는 무시됨
+
+	written, err := io.Copy(tmp, io.LimitReader(body, limit+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "가져오기 파일 저장 실패")
+	}
+	if written > limit {
+		return 0, errors.NewImportTooLargeError(limit)
+	}
+	if written == 0 {
+		return 0, errors.NewInvalidParameterError("body", "업로드할 파일이 비어 있습니다")
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(importID)); err != nil {
+		return 0, errors.Wrapf(err, "가져오기 파일 저장 실패")
+	}
+	return written, nil
+}
+
+// Open 보관 중인 파일과 크기, 없으면 nil
+func (s *ImportStager) Open(importID string) (*os.File, int64, error) {
+	file, err := os.Open(s.path(importID))
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "가져오기 파일 열기 실패")
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, errors.Wrapf(err, "가져오기 파일 조회 실패")
+	}
+	return file, info.Size(), nil
+}
+
+func (s *ImportStager) Remove(importID string) error {
+	if err := os.Remove(s.path(importID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// SourceURL import Job 이 파일을 내려받을 주소
+func (s *ImportStager) SourceURL(importID, token string) string {
+	return fmt.Sprintf("%s/internal/imports/%s/payload?token=%s",
+		s.baseURL, url.PathEscape(importID), url.QueryEscape(token))
+}
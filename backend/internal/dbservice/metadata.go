@@ -0,0 +1,67 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// UpdateMetadata CRD 라벨을 먼저 반영하고 DB 에 저장, CRD 가 아직 없으면 생성할 때 DB 의 라벨이 적용됨
+func (s *service) UpdateMetadata(ctx context.Context, userID, instanceID string, req *dbservice.UpdateMetadataRequest) (*dbservice.DBInstance, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	labels := instance.Labels
+	if req.Labels != nil {
+		labels = req.Labels
+	}
+	description := instance.Description
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	// CRD 가 거부하면 DB 는 그대로 둠
+	labelsApplied := false
+	if req.Labels != nil {
+		applied, err := s.setUserLabels(ctx, instance, labels)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		labelsApplied = applied
+	}
+
+	if err := s.dbiStore.UpdateMetadata(ctx, instance.ID, labels, description); err != nil {
+		if labelsApplied {
+			if _, rollbackErr := s.setUserLabels(ctx, instance, instance.Labels); rollbackErr != nil {
+				s.logger.Printf("CRITICAL: 인스턴스 %s 라벨 롤백 실패: %v", instance.ExternalID, rollbackErr)
+			}
+		}
+		return nil, errors.Wrap(err)
+	}
+
+	instance.Labels = labels
+	instance.Description = description
+	return instance, nil
+}
+
+// setUserLabels CRD 사용자 라벨 교체, CRD 가 아직 없으면 applied=false
+func (s *service) setUserLabels(ctx context.Context, instance *dbservice.DBInstance, labels map[string]string) (bool, error) {
+	if instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return false, nil
+	}
+	err := s.k8sClient.SetUserLabels(ctx, instance.K8sNamespace, instance.K8sResourceName, labels)
+	var domainErr errors.DomainError
+	if errors.As(err, &domainErr) && domainErr.Code() == errors.ErrResourceNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
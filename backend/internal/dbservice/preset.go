@@ -0,0 +1,150 @@
+package dbservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// CreatePreset 본인 인스턴스의 타입/모드/리소스/설정/백업 정책을 비공개 프리셋으로 저장
+// 비용은 커스텀 스펙과 같은 방식으로 다시 계산 (시스템 프리셋 가격을 그대로 복사하지 않음)
+func (s *service) CreatePreset(ctx context.Context, userID string, req *dbservice.CreatePresetRequest) (*dbservice.DBPreset, error) {
+	instance, err := s.dbiStore.Find(ctx, req.InstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", req.InstanceID)
+	}
+
+	count, err := s.presetStore.CountByOwner(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if count >= dbservice.MaxPresetsPerUser {
+		return nil, errors.NewLimitExceededError("preset", dbservice.MaxPresetsPerUser)
+	}
+
+	config := make(map[string]interface{}, len(instance.Config))
+	for k, v := range instance.Config {
+		config[k] = v
+	}
+
+	preset := &dbservice.DBPreset{
+		ID:            "user-" + uuid.New().String(),
+		Type:          instance.Type,
+		Size:          instance.Size,
+		Mode:          instance.Mode,
+		Name:          req.Name,
+		Icon:          req.Icon,
+		Description:   req.Description,
+		UseCases:      []string{},
+		Resources:     instance.Resources,
+		Cost:          dbservice.CalculateCustomCost(instance.Type, instance.Resources),
+		DefaultConfig: config,
+		SortOrder:     dbservice.UserPresetSortOrder,
+		OwnerID:       userID,
+		Visibility:    dbservice.PresetPrivate,
+		Backup: dbservice.BackupConfig{
+			Enabled:       instance.BackupConfig.Enabled,
+			Schedule:      instance.BackupConfig.Schedule,
+			RetentionDays: instance.BackupConfig.RetentionDays,
+		},
+	}
+	if err := s.presetStore.Create(ctx, preset); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 에서 프리셋 %s 저장", instance.ExternalID, preset.ID)
+	return preset, nil
+}
+
+func (s *service) ListUserPresets(ctx context.Context, userID string) ([]*dbservice.DBPreset, error) {
+	presets, err := s.presetStore.ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return presets, nil
+}
+
+func (s *service) DeletePreset(ctx context.Context, userID, presetID string) error {
+	if _, err := s.findUserPreset(ctx, userID, presetID); err != nil {
+		return err
+	}
+	if err := s.presetStore.Delete(ctx, presetID, userID); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// PublishPreset 갤러리 공개 신청, 심사 후 ListPresets 에 표시
+func (s *service) PublishPreset(ctx context.Context, userID, presetID string) (*dbservice.DBPreset, error) {
+	preset, err := s.findUserPreset(ctx, userID, presetID)
+	if err != nil {
+		return nil, err
+	}
+	from := []dbservice.PresetVisibility{dbservice.PresetPrivate, dbservice.PresetRejected}
+	if !slices.Contains(from, preset.Visibility) {
+		return nil, errors.NewInvalidStatusTransitionError(string(preset.Visibility), string(dbservice.PresetPending))
+	}
+
+	if err := s.presetStore.UpdateVisibility(ctx, preset.ID, from, dbservice.PresetPending, ""); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("프리셋 %s 공개 신청", preset.ID)
+	preset.Visibility = dbservice.PresetPending
+	preset.ModerationNote = ""
+	return preset, nil
+}
+
+func (s *service) ListPendingPresets(ctx context.Context) ([]*dbservice.DBPreset, error) {
+	presets, err := s.presetStore.ListPending(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return presets, nil
+}
+
+// ModeratePreset 공개 신청 승인/거절, 관리자만 호출
+func (s *service) ModeratePreset(ctx context.Context, presetID string, req *dbservice.ModeratePresetRequest) (*dbservice.DBPreset, error) {
+	preset, err := s.presetStore.Find(ctx, presetID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if preset == nil || !preset.IsUserPreset() {
+		return nil, errors.NewResourceNotFoundError("preset", presetID)
+	}
+
+	visibility := dbservice.PresetRejected
+	if req.Approve {
+		visibility = dbservice.PresetPublic
+	}
+	if preset.Visibility != dbservice.PresetPending {
+		return nil, errors.NewInvalidStatusTransitionError(string(preset.Visibility), string(visibility))
+	}
+
+	if err := s.presetStore.UpdateVisibility(ctx, preset.ID,
+		[]dbservice.PresetVisibility{dbservice.PresetPending}, visibility, req.Note); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("프리셋 %s 공개 심사: %s", preset.ID, visibility)
+	preset.Visibility = visibility
+	preset.ModerationNote = req.Note
+	return preset, nil
+}
+
+func (s *service) findUserPreset(ctx context.Context, userID, presetID string) (*dbservice.DBPreset, error) {
+	preset, err := s.presetStore.Find(ctx, presetID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if preset == nil || preset.OwnerID != userID {
+		return nil, errors.NewResourceNotFoundError("preset", presetID)
+	}
+	return preset, nil
+}
@@ -0,0 +1,406 @@
+package dbservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
+	"github.com/piper-hyowon/dBtree/internal/core/lemon"
+	"github.com/piper-hyowon/dBtree/internal/platform/k8s"
+)
+
+// provisionRun 사가 한 번의 실행에 필요한 값, 요청/작업/복구 워커가 같은 단계 함수를 사용
+type provisionRun struct {
+	saga     *dbservice.ProvisionSaga
+	instance *dbservice.DBInstance
+	dataset  *dbservice.SampleDataset
+	opts     provisionOptions
+}
+
+// newProvisionRun 저장된 사가 옵션으로 실행 상태 복원
+func (s *service) newProvisionRun(saga *dbservice.ProvisionSaga, instance *dbservice.DBInstance) (*provisionRun, error) {
+	dataset, err := lookupSampleDataset(instance.Type, saga.Options.SampleDataset)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &provisionRun{saga: saga, instance: instance, dataset: dataset}
+	if saga.Options.CloneInstance != "" {
+		run.opts.cloneFrom = &k8s.CloneSourceSpec{
+			Instance: saga.Options.CloneInstance,
+			Archive:  saga.Options.CloneArchive,
+		}
+	}
+	return run, nil
+}
+
+// errProvisionRolledBack 보상까지 끝나 더 진행할 수 없음
+var errProvisionRolledBack = errors.New("인스턴스 생성이 롤백되었습니다")
+
+// runProvisionSteps 완료되지 않은 단계부터 last 까지 순서대로 실행, 마지막 단계까지 끝나면 사가 완료
+func (s *service) runProvisionSteps(ctx context.Context, run *provisionRun, last dbservice.ProvisionStep) error {
+	for _, step := range dbservice.ProvisionSteps {
+		if run.saga.StepStatus(step) != dbservice.StepCompleted {
+			if err := s.runProvisionStep(ctx, run, step); err != nil {
+				return err
+			}
+		}
+		if step == last {
+			break
+		}
+	}
+	if last != dbservice.ProvisionSteps[len(dbservice.ProvisionSteps)-1] {
+		return nil
+	}
+
+	run.saga.Status = dbservice.SagaCompleted
+	run.saga.LastError = ""
+	return errors.Wrap(s.sagaStore.Update(ctx, run.saga))
+}
+
+func (s *service) runProvisionStep(ctx context.Context, run *provisionRun, step dbservice.ProvisionStep) error {
+	// 이전 실행이 started 에서 멈췄으면 실제로 반영됐는지 단계 함수가 확인
+	resumed := run.saga.StepStatus(step) == dbservice.StepStarted
+	if err := s.setSagaStep(ctx, run.saga, step, dbservice.StepStarted, ""); err != nil {
+		return err
+	}
+
+	var err error
+	switch step {
+	case dbservice.StepDeductLemons:
+		err = s.deductCreationCost(ctx, run, resumed)
+	case dbservice.StepAllocatePort:
+		s.allocateExternalPort(ctx, run.instance)
+	case dbservice.StepStageSampleData:
+		err = s.stageProvisionSampleData(ctx, run)
+	case dbservice.StepProvisionK8s:
+		err = s.provisionK8sResources(ctx, run.instance, run.opts)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "provision step %s", step)
+	}
+
+	return s.setSagaStep(ctx, run.saga, step, dbservice.StepCompleted, "")
+}
+
+func (s *service) setSagaStep(ctx context.Context, saga *dbservice.ProvisionSaga, step dbservice.ProvisionStep,
+	status dbservice.ProvisionStepStatus, errMsg string) error {
+	if err := s.sagaStore.SetStep(ctx, saga.ID, step, status, errMsg); err != nil {
+		return errors.Wrap(err)
+	}
+	saga.Steps[step] = status
+	return nil
+}
+
+func (s *service) deductCreationCost(ctx context.Context, run *provisionRun, resumed bool) error {
+	instance := run.instance
+	if resumed {
+		deducted, err := s.lemonService.HasInstanceTransaction(ctx, instance.ID, lemon.ActionInstanceCreate)
+		if err != nil {
+			return err
+		}
+		if deducted {
+			return nil
+		}
+	}
+
+	return s.lemonService.DeductLemons(ctx, instance.UserID, instance.Cost.CreationCost,
+		lemon.ActionInstanceCreate, fmt.Sprintf("인스턴스 %s 생성", instance.Name), &instance.ID)
+}
+
+// allocateExternalPort K8s 리소스 생성 전에 할당, 게이트웨이만 쓰는 경우 NodePort 없음
+// 이미 할당된 포트가 있으면 그대로 사용하고, 실패해도 게이트웨이로 접근 가능하므로 계속 진행
+func (s *service) allocateExternalPort(ctx context.Context, instance *dbservice.DBInstance) {
+	if s.portStore == nil || !s.access.NodePortEnabled {
+		return
+	}
+
+	port, err := s.portStore.AllocatePort(ctx, instance.ExternalID)
+	if err != nil {
+		s.logger.Printf("WARNING: 외부 포트 할당 실패: %v", err)
+		return
+	}
+	s.logger.Printf("DEBUG: Port %d allocated successfully", port)
+	instance.ExternalPort = port
+
+	if err := s.dbiStore.Update(ctx, instance); err != nil {
+		s.logger.Printf("Failed to update port info in DB: %v", err)
+	}
+}
+
+// stageProvisionSampleData 오퍼레이터가 Running 이후 import Job 으로 적재, 준비 실패는 무시
+func (s *service) stageProvisionSampleData(ctx context.Context, run *provisionRun) error {
+	if run.dataset == nil {
+		return nil
+	}
+
+	// 재개: 이미 준비된 import 로 spec 복원
+	if importID := run.saga.Options.DataImportID; importID != "" {
+		imp, err := s.dbiStore.FindImport(ctx, importID)
+		if err != nil {
+			return err
+		}
+		if imp != nil {
+			run.opts.dataImport = &k8s.DataImportSpec{
+				ID:         imp.ID,
+				Format:     string(imp.Format),
+				SourceURL:  s.importStager.SourceURL(imp.ID, imp.StagingToken),
+				Database:   imp.Database,
+				Collection: imp.Collection,
+			}
+		}
+		return nil
+	}
+
+	run.opts.dataImport = s.stageSampleDataset(ctx, run.instance, run.dataset)
+	if run.opts.dataImport == nil {
+		return nil
+	}
+	run.saga.Options.DataImportID = run.opts.dataImport.ID
+	return s.sagaStore.Update(ctx, run.saga)
+}
+
+// compensateProvisioning 실행된 단계를 역순으로 되돌림
+// 환불이 실패하면 lemon_refund_failures 에 남기고 사가는 failed, 나머지 보상은 best-effort
+func (s *service) compensateProvisioning(ctx context.Context, run *provisionRun, cause error) {
+	saga, instance := run.saga, run.instance
+	// 요청이 끊겨도 보상은 끝까지 진행
+	ctx = context.WithoutCancel(ctx)
+
+	saga.Status = dbservice.SagaCompensating
+	if cause != nil {
+		saga.LastError = cause.Error()
+	}
+	if err := s.sagaStore.Update(ctx, saga); err != nil {
+		s.logger.Printf("사가 상태 저장 실패 (%d): %v", saga.ID, err)
+	}
+
+	charged := false
+	for i := len(dbservice.ProvisionSteps) - 1; i >= 0; i-- {
+		step := dbservice.ProvisionSteps[i]
+		status := saga.StepStatus(step)
+		if status != dbservice.StepStarted && status != dbservice.StepCompleted {
+			continue
+		}
+
+		var err error
+		switch step {
+		case dbservice.StepProvisionK8s:
+			s.compensateK8sResources(ctx, instance)
+		case dbservice.StepStageSampleData:
+			if importID := saga.Options.DataImportID; importID != "" {
+				_ = s.dbiStore.UpdateImportStatus(ctx, importID, dbservice.ImportStatusFailed, "K8s provisioning failed")
+				s.removeStagedImport(importID)
+			}
+		case dbservice.StepAllocatePort:
+			if s.portStore != nil {
+				_ = s.portStore.ReleasePort(ctx, instance.ExternalID)
+			}
+		case dbservice.StepDeductLemons:
+			charged, err = s.refundCreationCost(ctx, run, cause)
+		}
+
+		next := dbservice.StepCompensated
+		errMsg := ""
+		if err != nil {
+			next, errMsg = dbservice.StepFailed, err.Error()
+			saga.LastError = errMsg
+		}
+		if err := s.setSagaStep(ctx, saga, step, next, errMsg); err != nil {
+			s.logger.Printf("사가 단계 저장 실패 (%d/%s): %v", saga.ID, step, err)
+		}
+	}
+
+	// 차감 전에 실패했으면 인스턴스를 남기지 않음 (같은 이름으로 다시 생성 가능)
+	if charged {
+		_ = s.dbiStore.UpdateStatus(ctx, instance.ID, dbservice.StatusError, "provisioning failed")
+	} else {
+		_ = s.dbiStore.Delete(ctx, instance.ExternalID)
+	}
+
+	saga.Status = dbservice.SagaCompensated
+	if saga.StepStatus(dbservice.StepDeductLemons) == dbservice.StepFailed {
+		saga.Status = dbservice.SagaFailed
+	}
+	if err := s.sagaStore.Update(ctx, saga); err != nil {
+		s.logger.Printf("사가 상태 저장 실패 (%d): %v", saga.ID, err)
+	}
+}
+
+// compensateK8sResources CRD 생성 도중 멈췄을 수 있으므로 이름이 없으면 생성 규칙으로 추정
+func (s *service) compensateK8sResources(ctx context.Context, instance *dbservice.DBInstance) {
+	if instance.K8sNamespace == "" {
+		instance.K8sNamespace = fmt.Sprintf("user-%s", instance.UserID)
+	}
+	if instance.K8sResourceName == "" {
+		instance.K8sResourceName = instance.Name
+	}
+	_ = s.cleanupK8sResources(ctx, instance)
+}
+
+// refundCreationCost 차감된 생성 비용 환불, charged 는 실제로 차감됐었는지 여부
+func (s *service) refundCreationCost(ctx context.Context, run *provisionRun, cause error) (charged bool, err error) {
+	instance := run.instance
+	if run.saga.StepStatus(dbservice.StepDeductLemons) == dbservice.StepStarted {
+		deducted, err := s.lemonService.HasInstanceTransaction(ctx, instance.ID, lemon.ActionInstanceCreate)
+		if err != nil {
+			return true, s.recordRefundFailure(ctx, run, err)
+		}
+		if !deducted {
+			return false, nil
+		}
+	}
+
+	// 이전 보상에서 이미 환불된 경우
+	refunded, err := s.lemonService.HasInstanceTransaction(ctx, instance.ID, lemon.ActionInstanceCreateRefund)
+	if err == nil && refunded {
+		return true, nil
+	}
+
+	reason := "provisioning failed"
+	if cause != nil {
+		reason = cause.Error()
+	}
+	if err := s.lemonService.AddLemons(ctx, instance.UserID, instance.Cost.CreationCost,
+		lemon.ActionInstanceCreateRefund, fmt.Sprintf("실패: %s", reason), &instance.ID); err != nil {
+		return true, s.recordRefundFailure(ctx, run, err)
+	}
+	return true, nil
+}
+
+func (s *service) recordRefundFailure(ctx context.Context, run *provisionRun, refundErr error) error {
+	instance := run.instance
+	s.logger.Printf("CRITICAL: 환불 실패 - userID: %s, instanceID: %d, amount: %d, error: %v",
+		instance.UserID, instance.ID, instance.Cost.CreationCost, refundErr)
+
+	failure := &lemon.RefundFailure{
+		UserID:       instance.UserID,
+		Amount:       instance.Cost.CreationCost,
+		InstanceID:   &instance.ID,
+		SagaID:       &run.saga.ID,
+		Reason:       fmt.Sprintf("인스턴스 %s 생성 실패", instance.Name),
+		ErrorMessage: refundErr.Error(),
+	}
+	if err := s.lemonService.RecordRefundFailure(ctx, failure); err != nil {
+		s.logger.Printf("CRITICAL: 환불 실패 기록 실패 - userID: %s, instanceID: %d, error: %v",
+			instance.UserID, instance.ID, err)
+	}
+	return refundErr
+}
+
+// ResumeProvisioning 점유가 만료된(처리하던 서버가 죽었거나 작업이 끝내 실패한) 사가를 이어서 진행하거나 보상
+func (s *service) ResumeProvisioning(ctx context.Context, limit int) (int, error) {
+	sagas, err := s.sagaStore.ClaimStale(ctx, limit)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+
+	for _, saga := range sagas {
+		s.logger.Printf("인스턴스 생성 사가 재개: saga %d (attempt %d)", saga.ID, saga.Attempts)
+		if err := s.advanceProvisionSaga(ctx, saga, saga.Attempts < dbservice.ProvisionSagaMaxAttempts); err != nil {
+			s.logger.Printf("인스턴스 생성 사가 재개 실패 (%d): %v", saga.ID, err)
+		}
+	}
+	return len(sagas), nil
+}
+
+// advanceProvisionSaga 점유한 사가를 끝까지 진행하거나 보상
+// retry 가 true 이고 재시도할 수 있는 실패면 보상하지 않고 에러 반환, 보상했으면 errProvisionRolledBack
+func (s *service) advanceProvisionSaga(ctx context.Context, saga *dbservice.ProvisionSaga, retry bool) error {
+	instance, err := s.dbiStore.FindByID(ctx, saga.InstanceID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if instance == nil {
+		// 생성 중에 삭제됨, 리소스 정리는 삭제 작업이 담당
+		saga.Status = dbservice.SagaCompensated
+		saga.LastError = "instance deleted"
+		if err := s.sagaStore.Update(ctx, saga); err != nil {
+			s.logger.Printf("사가 상태 저장 실패 (%d): %v", saga.ID, err)
+		}
+		return fmt.Errorf("%w: %s", errProvisionRolledBack, saga.LastError)
+	}
+
+	run, err := s.newProvisionRun(saga, instance)
+	if err != nil {
+		return s.rollbackProvisioning(ctx, &provisionRun{saga: saga, instance: instance}, err)
+	}
+	if saga.Status == dbservice.SagaCompensating {
+		return s.rollbackProvisioning(ctx, run, errors.New(saga.LastError))
+	}
+
+	last := dbservice.ProvisionSteps[len(dbservice.ProvisionSteps)-1]
+	if err := s.runProvisionSteps(ctx, run, last); err != nil {
+		if !retry || !retryableProvisionError(err) {
+			return s.rollbackProvisioning(ctx, run, err)
+		}
+		saga.LastError = err.Error()
+		if err := s.sagaStore.Update(ctx, saga); err != nil {
+			s.logger.Printf("사가 상태 저장 실패 (%d): %v", saga.ID, err)
+		}
+		return err
+	}
+
+	s.logger.Printf("인스턴스 생성 완료: %s (saga %d)", instance.ExternalID, saga.ID)
+	return nil
+}
+
+func (s *service) rollbackProvisioning(ctx context.Context, run *provisionRun, cause error) error {
+	s.compensateProvisioning(ctx, run, cause)
+	return fmt.Errorf("%w: %v", errProvisionRolledBack, cause)
+}
+
+// handleProvisionJob 요청에서 차감/포트 할당까지 끝낸 사가의 나머지 단계 실행
+func (s *service) handleProvisionJob(ctx context.Context, j *job.Job) error {
+	var payload dbservice.ProvisionJobPayload
+	if err := json.Unmarshal(j.Payload, &payload); err != nil {
+		return job.Permanent(err)
+	}
+
+	saga, err := s.sagaStore.Claim(ctx, payload.SagaID)
+	if err != nil {
+		return err
+	}
+	if saga == nil {
+		// 복구 워커가 처리 중이거나 이미 끝남
+		saga, err := s.sagaStore.Find(ctx, payload.SagaID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case saga == nil:
+			return job.Permanent(fmt.Errorf("provision saga %d not found", payload.SagaID))
+		case saga.Status == dbservice.SagaCompleted:
+			return nil
+		case saga.Status.Finished():
+			return job.Permanent(fmt.Errorf("%w: %s", errProvisionRolledBack, saga.LastError))
+		default:
+			return fmt.Errorf("provision saga %d is being processed elsewhere", saga.ID)
+		}
+	}
+
+	err = s.advanceProvisionSaga(ctx, saga, !j.LastAttempt())
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, errProvisionRolledBack) {
+		return job.Permanent(err)
+	}
+	// 다음 재시도가 바로 가져갈 수 있도록 점유 해제
+	if err := s.sagaStore.Release(context.WithoutCancel(ctx), saga.ID); err != nil {
+		s.logger.Printf("사가 점유 해제 실패 (%d): %v", saga.ID, err)
+	}
+	return err
+}
+
+// retryableProvisionError 잔액 부족 같은 도메인 에러는 재시도해도 같은 결과
+func retryableProvisionError(err error) bool {
+	var domainErr errors.DomainError
+	if !errors.As(err, &domainErr) {
+		return true
+	}
+	return domainErr.Code() == errors.ErrInternalServer || domainErr.Code() == errors.ErrUnknown
+}
@@ -0,0 +1,127 @@
+package dbservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/lemon"
+)
+
+const (
+	provisionWorkerBatch = 20
+	refundRetryBatch     = 50
+)
+
+// ProvisionWorker 중단된 인스턴스 생성 사가를 이어서 처리하고, 실패한 환불을 재시도
+type ProvisionWorker struct {
+	service      *service
+	lemonService lemon.Service
+	logger       *log.Logger
+
+	ticker    *time.Ticker
+	done      chan bool
+	mutex     sync.Mutex
+	isRunning bool
+	interval  time.Duration
+}
+
+func NewProvisionWorker(dbsService dbservice.Service, lemonService lemon.Service, logger *log.Logger, interval time.Duration) (*ProvisionWorker, error) {
+	svc, ok := dbsService.(*service)
+	if !ok {
+		return nil, fmt.Errorf("unsupported dbservice implementation: %T", dbsService)
+	}
+	if interval <= 0 {
+		interval = 1 * time.Minute
+	}
+
+	return &ProvisionWorker{
+		service:      svc,
+		lemonService: lemonService,
+		logger:       logger,
+		interval:     interval,
+		done:         make(chan bool),
+	}, nil
+}
+
+func (w *ProvisionWorker) Start() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.isRunning {
+		w.logger.Println("인스턴스 생성 복구 워커가 이미 실행 중입니다")
+		return nil
+	}
+
+	w.ticker = time.NewTicker(w.interval)
+	w.done = make(chan bool)
+	w.isRunning = true
+
+	go w.run()
+	w.logger.Println("인스턴스 생성 복구 워커가 시작되었습니다")
+	return nil
+}
+
+func (w *ProvisionWorker) Stop() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.isRunning {
+		w.logger.Println("인스턴스 생성 복구 워커가 이미 중지됨")
+		return nil
+	}
+
+	w.ticker.Stop()
+	w.done <- true
+	w.isRunning = false
+	w.logger.Println("인스턴스 생성 복구 워커가 중지되었습니다")
+	return nil
+}
+
+func (w *ProvisionWorker) IsRunning() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.isRunning
+}
+
+// RunNow 즉시 실행 (테스트/관리용)
+func (w *ProvisionWorker) RunNow(ctx context.Context) error {
+	w.process()
+	return nil
+}
+
+func (w *ProvisionWorker) run() {
+	// 시작할 때 한번 실행, 재시작 직전에 멈춘 사가 처리
+	w.process()
+
+	for {
+		select {
+		case <-w.ticker.C:
+			w.process()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *ProvisionWorker) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	resumed, err := w.service.ResumeProvisioning(ctx, provisionWorkerBatch)
+	if err != nil {
+		w.logger.Printf("인스턴스 생성 사가 재개 실패: %v", err)
+	} else if resumed > 0 {
+		w.logger.Printf("인스턴스 생성 사가 %d건 처리", resumed)
+	}
+
+	refunded, err := w.lemonService.RetryRefundFailures(ctx, refundRetryBatch)
+	if err != nil {
+		w.logger.Printf("환불 재시도 실패: %v", err)
+	} else if refunded > 0 {
+		w.logger.Printf("실패한 환불 %d건 지급 완료", refunded)
+	}
+}
@@ -0,0 +1,86 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/resource"
+)
+
+// QuoteInstance CreateInstance 와 같은 확인을 하되 첫 거절에서 멈추지 않고 사유를 모아서 반환
+// 레몬 차감, 포트 할당 등 아무것도 만들지 않음
+func (s *service) QuoteInstance(ctx context.Context, userID string, userLemon int, req *dbservice.CreateInstanceRequest) (*dbservice.InstanceQuote, error) {
+	quote := &dbservice.InstanceQuote{Errors: []dbservice.QuoteIssue{}}
+
+	existingInstances, err := s.dbiStore.CountActive(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if existingInstances >= dbservice.MaxInstancesPerUser {
+		_ = quote.AddError(errors.NewLimitExceededError("instance", dbservice.MaxInstancesPerUser))
+	}
+
+	if req.Name != "" {
+		existing, err := s.dbiStore.FindByUserAndName(ctx, userID, req.Name)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if existing != nil && existing.DeletedAt != nil {
+			_ = quote.AddError(errors.NewInstanceNameInRecycleBinError(req.Name))
+		} else if existing != nil {
+			_ = quote.AddError(errors.NewInstanceNameConflictError(req.Name))
+		}
+	}
+
+	instance, sampleDatasetID, _, err := s.buildInstance(ctx, userID, req, dbservice.BackupConfig{})
+	if err != nil {
+		// 스펙을 결정할 수 없으면 비용도 계산할 수 없음
+		if err := quote.AddError(err); err != nil {
+			return nil, err
+		}
+		return quote, nil
+	}
+
+	cost := instance.Cost.ToResponse()
+	quote.Type = instance.Type
+	quote.Mode = instance.Mode
+	quote.Size = instance.Size
+	quote.Resources = &instance.Resources
+	quote.Config = instance.Config
+	quote.Cost = &cost
+
+	if err := validateCustomConfig(req, instance); err != nil {
+		_ = quote.AddError(err)
+	}
+
+	if req.SampleDataset != nil {
+		sampleDatasetID = *req.SampleDataset
+	}
+	if _, err := lookupSampleDataset(instance.Type, sampleDatasetID); err != nil {
+		_ = quote.AddError(err)
+	}
+
+	requested := resource.SystemResourceSpec{
+		CPU:    instance.Resources.CPU,
+		Memory: instance.Resources.Memory,
+	}
+	canAllocate, reason, err := s.resourceManager.CanAllocate(ctx, requested)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	quote.Capacity = &dbservice.CapacityVerdict{
+		CanAllocate: canAllocate,
+		Reason:      reason,
+		Requested:   requested,
+	}
+
+	balance := dbservice.NewBalanceCoverage(userLemon, instance.Cost)
+	quote.Balance = &balance
+	if !balance.CanAffordCreate {
+		_ = quote.AddError(errors.NewInsufficientLemonsError(instance.Cost.CreationCost+1, instance.Cost.CreationCost-userLemon))
+	}
+
+	quote.Valid = len(quote.Errors) == 0 && canAllocate
+	return quote, nil
+}
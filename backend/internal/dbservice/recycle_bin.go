@@ -0,0 +1,63 @@
+package dbservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+// UndeleteInstance 휴지통에서 복구, 중지 상태로 돌아오므로 사용하려면 다시 시작해야 함
+func (s *service) UndeleteInstance(ctx context.Context, userID, instanceID string) (*dbservice.DBInstance, error) {
+	instance, err := s.dbiStore.FindDeleted(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	if instance.PurgeAfter != nil && time.Now().After(*instance.PurgeAfter) {
+		// 보관 기간이 지나 리퍼가 정리할 예정
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	count, err := s.dbiStore.CountActive(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if count >= dbservice.MaxInstancesPerUser {
+		return nil, errors.NewLimitExceededError("instance", dbservice.MaxInstancesPerUser)
+	}
+
+	if err := s.dbiStore.Undelete(ctx, instance); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 휴지통에서 복구", instance.ExternalID)
+	restored, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if restored == nil {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	return restored, nil
+}
+
+func (s *service) PurgeExpiredInstances(ctx context.Context, limit int) (int, error) {
+	instances, err := s.dbiStore.ListPurgeDue(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+
+	purged := 0
+	for _, instance := range instances {
+		if err := s.purgeInstance(ctx, instance); err != nil {
+			s.logger.Printf("휴지통 인스턴스 %s 정리 실패: %v", instance.ExternalID, err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
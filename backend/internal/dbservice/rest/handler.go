@@ -1,31 +1,40 @@
 package rest
 
 import (
+	"bufio"
 	"fmt"
+	"github.com/piper-hyowon/dBtree/internal/common"
 	coredbservice "github.com/piper-hyowon/dBtree/internal/core/dbservice"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
 	"github.com/piper-hyowon/dBtree/internal/platform/rest"
 	"github.com/piper-hyowon/dBtree/internal/platform/rest/router"
 	"github.com/piper-hyowon/dBtree/internal/platform/validation"
+	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Handler struct {
-	publicDBHost string
-	dbService    coredbservice.Service
-	portStore    coredbservice.PortStore
-	logger       *log.Logger
+	access    coredbservice.ExternalAccess
+	dbService coredbservice.Service
+	portStore coredbservice.PortStore
+	clientIP  *rest.ClientIPResolver
+	logger    *log.Logger
 }
 
 func NewHandler(
-	publicDBHost string, dbService coredbservice.Service, portStore coredbservice.PortStore, logger *log.Logger,
+	access coredbservice.ExternalAccess, dbService coredbservice.Service, portStore coredbservice.PortStore,
+	clientIP *rest.ClientIPResolver, logger *log.Logger,
 ) *Handler {
 	return &Handler{
-		publicDBHost: publicDBHost,
-		dbService:    dbService,
-		portStore:    portStore,
-		logger:       logger,
+		access:    access,
+		dbService: dbService,
+		portStore: portStore,
+		clientIP:  clientIP,
+		logger:    logger,
 	}
 }
 
@@ -60,6 +69,42 @@ func (h *Handler) CreateInstance(w http.ResponseWriter, r *http.Request) {
 	rest.SendJSONResponse(w, http.StatusAccepted, resp)
 }
 
+// QuoteInstance 생성 요청 본문을 검증 실패로 거절하지 않고 견적의 errors 로 돌려줌
+func (h *Handler) QuoteInstance(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	var dto coredbservice.CreateInstanceRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	var invalid []error
+	if err := validation.ValidateStruct(&dto); err != nil {
+		invalid = append(invalid, err)
+	}
+	if err := dto.Validate(); err != nil {
+		invalid = append(invalid, err)
+	}
+
+	quote, err := h.dbService.QuoteInstance(r.Context(), user.ID, user.LemonBalance, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+	for _, e := range invalid {
+		if err := quote.AddError(e); err != nil {
+			rest.HandleError(w, err, h.logger)
+			return
+		}
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, quote)
+}
+
 func (h *Handler) GetInstanceWithSync(w http.ResponseWriter, r *http.Request) {
 	user, err := rest.GetUserFromContext(r.Context())
 	if err != nil {
@@ -73,43 +118,824 @@ func (h *Handler) GetInstanceWithSync(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	instance, err := h.dbService.GetInstanceWithSync(r.Context(), user.ID, id)
+	instance, err := h.dbService.GetInstanceWithSync(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	response := instance.ToResponse()
+
+	var nodePort int
+	if h.access.NodePortEnabled {
+		if port, err := h.portStore.GetPort(r.Context(), instance.ExternalID); err == nil {
+			nodePort = port
+		}
+	}
+	if endpoint := h.access.Endpoint(instance.ExternalID, nodePort); endpoint != nil {
+		response.ExternalHost = endpoint.Host
+		response.ExternalPort = endpoint.Port
+
+		// URI 템플릿 생성
+		if engine, ok := coredbservice.LookupEngine(instance.Type); ok {
+			response.ExternalURITemplate = engine.ConnectionURI("{USERNAME}", "{PASSWORD}",
+				endpoint.Host, endpoint.Port, instance.Name, endpoint.TLS)
+		}
+	}
+	rest.SendSuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *Handler) ListInstanceEvents(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	events, err := h.dbService.ListInstanceEvents(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, events)
+}
+
+// StreamInstanceLogs 쿼리: follow, since(RFC3339), tail, pod, previous
+// Accept 가 text/event-stream 이면 SSE, 아니면 text/plain chunked 응답
+func (h *Handler) StreamInstanceLogs(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	req := coredbservice.InstanceLogsRequest{
+		Pod:      rest.GetStringQuery(r, "pod"),
+		Follow:   rest.GetBoolQuery(r, "follow", false),
+		Tail:     rest.GetIntQuery(r, "tail", coredbservice.DefaultLogTailLines),
+		Previous: rest.GetBoolQuery(r, "previous", false),
+	}
+	if since := rest.GetStringQuery(r, "since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			rest.HandleError(w, errors.NewInvalidParameterError("since", "RFC3339 형식이어야 합니다"), h.logger)
+			return
+		}
+		req.Since = &t
+	}
+
+	if err := req.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	stream, err := h.dbService.StreamInstanceLogs(r.Context(), user.ID, id, &req)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+	defer stream.Close()
+
+	sse := strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+	controller := http.NewResponseController(w)
+	if req.Follow {
+		// follow 는 클라이언트가 끊을 때까지 유지되므로 서버 WriteTimeout 해제
+		_ = controller.SetWriteDeadline(time.Time{})
+	}
+
+	if sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+	} else {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = controller.Flush()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if sse {
+			fmt.Fprintf(w, "data: %s\n\n", scanner.Text())
+		} else {
+			fmt.Fprintln(w, scanner.Text())
+		}
+		if req.Follow {
+			if err := controller.Flush(); err != nil {
+				return
+			}
+		}
+	}
+
+	// 클라이언트가 연결을 끊은 경우는 정상 종료
+	if err := scanner.Err(); err != nil && r.Context().Err() == nil {
+		h.logger.Printf("로그 스트림 중단 (%s): %v", id, err)
+		if sse {
+			fmt.Fprint(w, "event: error\ndata: 로그 스트림이 중단되었습니다\n\n")
+		}
+		return
+	}
+	if sse {
+		fmt.Fprint(w, "event: end\ndata: \n\n")
+	}
+}
+
+func (h *Handler) GetAllowlist(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	resp, err := h.dbService.GetAllowlist(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, resp)
+}
+
+// UpdateInstance 리소스/설정/백업 설정 변경, 오퍼레이터 적용은 비동기
+func (h *Handler) UpdateInstance(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateInstanceRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := validation.ValidateStruct(&dto); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	instance, err := h.dbService.UpdateInstance(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusAccepted, instance.ToResponse())
+}
+
+func (h *Handler) UpdateAllowlist(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateAllowlistRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	resp, err := h.dbService.UpdateAllowlist(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	// 오퍼레이터 적용은 비동기
+	rest.SendSuccessResponse(w, http.StatusAccepted, resp)
+}
+
+func (h *Handler) UpdateProfiling(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateProfilingRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	resp, err := h.dbService.UpdateProfiling(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	// 오퍼레이터가 설정을 반영하며 재시작
+	rest.SendSuccessResponse(w, http.StatusAccepted, resp)
+}
+
+func (h *Handler) UpdateIdlePolicy(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateIdlePolicyRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	policy, err := h.dbService.UpdateIdlePolicy(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, policy)
+}
+
+func (h *Handler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	schedule, err := h.dbService.GetSchedule(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, schedule.ToResponse())
+}
+
+// UpdateSchedule 자동 시작/중지 일정 설정, cron 표현식은 timezone 기준
+func (h *Handler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateScheduleRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := validation.ValidateStruct(&dto); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	schedule, err := h.dbService.UpdateSchedule(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, schedule.ToResponse())
+}
+
+func (h *Handler) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	if err := h.dbService.DeleteSchedule(r.Context(), user.ID, id); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+func (h *Handler) SlowQueries(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	req := coredbservice.SlowQueryRequest{
+		Limit: rest.GetIntQuery(r, "limit", coredbservice.DefaultTopQueries),
+	}
+	if window := rest.GetStringQuery(r, "window"); window != "" {
+		d, err := time.ParseDuration(window)
+		if err != nil {
+			rest.HandleError(w, errors.NewInvalidParameterError("window", "1h, 24h 같은 기간 형식이어야 합니다"), h.logger)
+			return
+		}
+		req.Window = d
+	}
+
+	if err := req.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	report, err := h.dbService.SlowQueries(r.Context(), user.ID, id, &req)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, report)
+}
+
+func (h *Handler) RunConsoleCommand(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.ConsoleRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	result, err := h.dbService.RunConsoleCommand(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, result)
+}
+
+// importTransferTimeout 큰 파일 업로드/다운로드는 서버 기본 Read/WriteTimeout 대신 이 시간까지 허용
+const importTransferTimeout = time.Hour
+
+// StartImport 본문은 파일 자체 (application/octet-stream)
+// 쿼리: format(mongodump, json, csv, resp), database, collection, drop
+func (h *Handler) StartImport(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	req := coredbservice.StartImportRequest{
+		Format:     coredbservice.ImportFormat(rest.GetStringQuery(r, "format")),
+		Database:   rest.GetStringQuery(r, "database"),
+		Collection: rest.GetStringQuery(r, "collection"),
+		Drop:       rest.GetBoolQuery(r, "drop", false),
+	}
+	if err := req.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	deadline := time.Now().Add(importTransferTimeout)
+	_ = controller.SetReadDeadline(deadline)
+	_ = controller.SetWriteDeadline(deadline)
+
+	imp, err := h.dbService.StartImport(r.Context(), user.ID, id, &req, r.Body)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	// 적재는 비동기, GET /db/instances/:id/imports/:importId 로 확인
+	rest.SendSuccessResponse(w, http.StatusAccepted, imp)
+}
+
+func (h *Handler) ListImports(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	imports, err := h.dbService.ListImports(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, imports)
+}
+
+func (h *Handler) GetImport(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+	importID := router.Param(r, "importId")
+	if importID == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("importId"), h.logger)
+		return
+	}
+
+	imp, err := h.dbService.GetImport(r.Context(), user.ID, id, importID)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, imp)
+}
+
+// DownloadImportPayload import Job 이 임시 보관된 파일을 내려받음
+func (h *Handler) DownloadImportPayload(w http.ResponseWriter, r *http.Request) {
+	importID := router.Param(r, "importId")
+	if importID == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("importId"), h.logger)
+		return
+	}
+
+	payload, size, err := h.dbService.OpenImportPayload(r.Context(), importID, rest.GetStringQuery(r, "token"))
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+	defer payload.Close()
+
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(importTransferTimeout))
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, payload); err != nil {
+		h.logger.Printf("가져오기 파일 전송 중단 (%s): %v", importID, err)
+	}
+}
+
+// GetBackupDownloadURL 백업 아카이브를 내려받을 서명된 주소 발급
+func (h *Handler) GetBackupDownloadURL(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+	backupID := router.Param(r, "backupId")
+	if backupID == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("backupId"), h.logger)
+		return
+	}
+
+	download, err := h.dbService.IssueBackupDownload(r.Context(), user.ID, id, backupID, h.clientInfo(r))
 	if err != nil {
 		rest.HandleError(w, err, h.logger)
 		return
 	}
 
-	response := instance.ToResponse()
-	if port, err := h.portStore.GetPort(r.Context(), instance.ExternalID); err == nil && port > 0 {
-		response.ExternalHost = h.publicDBHost
-		response.ExternalPort = port
-
-		// URI 템플릿 직접 생성
-		if instance.Type == coredbservice.MongoDB {
-			response.ExternalURITemplate = fmt.Sprintf(
-				"mongodb://{USERNAME}:{PASSWORD}@%s:%d/%s?authSource=admin",
-				h.publicDBHost, port, instance.Name)
-		} else if instance.Type == coredbservice.Redis {
-			response.ExternalURITemplate = fmt.Sprintf(
-				"redis://:{PASSWORD}@%s:%d",
-				h.publicDBHost, port)
-		}
+	rest.SendSuccessResponse(w, http.StatusOK, download)
+}
+
+// DownloadBackupArchive 서명된 주소로 백업 아카이브 스트리밍, 세션 대신 서명으로 인증
+func (h *Handler) DownloadBackupArchive(w http.ResponseWriter, r *http.Request) {
+	backupID := router.Param(r, "backupId")
+	if backupID == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("backupId"), h.logger)
+		return
 	}
-	rest.SendSuccessResponse(w, http.StatusOK, response)
+
+	expires, err := strconv.ParseInt(rest.GetStringQuery(r, "expires"), 10, 64)
+	if err != nil {
+		rest.HandleError(w, errors.NewInvalidDownloadLinkError(), h.logger)
+		return
+	}
+
+	archive, err := h.dbService.OpenBackupDownload(r.Context(), &coredbservice.SignedBackupURL{
+		BackupID:  backupID,
+		UserID:    rest.GetStringQuery(r, "uid"),
+		Expires:   expires,
+		Signature: rest.GetStringQuery(r, "signature"),
+	}, h.clientInfo(r))
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+	defer archive.Body.Close()
+
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(importTransferTimeout))
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", archive.FileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Length", strconv.FormatInt(archive.SizeBytes, 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, archive.Body); err != nil {
+		// 응답을 중단해 Content-Length 보다 짧은 파일이 정상 완료로 보이지 않게 함
+		h.logger.Printf("백업 다운로드 전송 중단 (%s): %v", backupID, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func (h *Handler) clientInfo(r *http.Request) coredbservice.ClientInfo {
+	return coredbservice.ClientInfo{
+		IP:        h.clientIP.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+}
+
+func (h *Handler) RotateCredentials(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	resp, err := h.dbService.RotateCredentials(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	// 오퍼레이터 적용은 비동기, rotation.status 로 확인
+	rest.SendSuccessResponse(w, http.StatusAccepted, resp)
 }
 
 func (h *Handler) ListPresets(w http.ResponseWriter, r *http.Request) {
 	presets, err := h.dbService.ListPresets(r.Context())
 	if err != nil {
 		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, presetResponses(presets))
+}
+
+func (h *Handler) CreatePreset(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	var dto coredbservice.CreatePresetRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := validation.ValidateStruct(&dto); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	preset, err := h.dbService.CreatePreset(r.Context(), user.ID, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusCreated, preset.ToResponse())
+}
+
+func (h *Handler) ListUserPresets(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	presets, err := h.dbService.ListUserPresets(r.Context(), user.ID)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, presetResponses(presets))
+}
+
+func (h *Handler) DeletePreset(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	if err := h.dbService.DeletePreset(r.Context(), user.ID, id); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+func (h *Handler) PublishPreset(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	preset, err := h.dbService.PublishPreset(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, preset.ToResponse())
+}
+
+func (h *Handler) ListPendingPresets(w http.ResponseWriter, r *http.Request) {
+	presets, err := h.dbService.ListPendingPresets(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, presetResponses(presets))
+}
+
+func (h *Handler) ModeratePreset(w http.ResponseWriter, r *http.Request) {
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.ModeratePresetRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := validation.ValidateStruct(&dto); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	preset, err := h.dbService.ModeratePreset(r.Context(), id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
 	}
 
+	rest.SendSuccessResponse(w, http.StatusOK, preset.ToResponse())
+}
+
+func presetResponses(presets []*coredbservice.DBPreset) []coredbservice.PresetResponse {
 	responses := make([]coredbservice.PresetResponse, len(presets))
 	for i, preset := range presets {
 		responses[i] = preset.ToResponse()
 	}
+	return responses
+}
+
+// ListSampleDatasets ?type= 으로 DB 타입별 필터
+func (h *Handler) ListSampleDatasets(w http.ResponseWriter, r *http.Request) {
+	dbType := coredbservice.DBType(strings.ToLower(r.URL.Query().Get("type")))
+
+	datasets, err := h.dbService.ListSampleDatasets(r.Context(), dbType)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
 
-	rest.SendSuccessResponse(w, http.StatusOK, responses)
+	rest.SendSuccessResponse(w, http.StatusOK, datasets)
 }
 
 func (h *Handler) ListInstances(w http.ResponseWriter, r *http.Request) {
@@ -119,7 +945,36 @@ func (h *Handler) ListInstances(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	instances, err := h.dbService.ListInstances(r.Context(), user.ID)
+	req := coredbservice.ListInstancesRequest{
+		PaginationParams: common.PaginationParams{
+			Page:  rest.GetIntQuery(r, "page", 1),
+			Limit: rest.GetIntQuery(r, "limit", coredbservice.DefaultInstancePageSize),
+		},
+		Deleted: rest.GetBoolQuery(r, "deleted", false), // 휴지통 목록
+		Sort:    coredbservice.InstanceSortField(rest.GetStringQuery(r, "sort")),
+		Order:   rest.GetStringQuery(r, "order"),
+	}
+
+	if selector := rest.GetStringQuery(r, "labels"); selector != "" {
+		req.Selector, err = coredbservice.ParseLabelSelector(selector)
+		if err != nil {
+			rest.HandleError(w, err, h.logger)
+			return
+		}
+	}
+	for _, status := range splitQueryList(rest.GetStringQuery(r, "status")) {
+		req.Statuses = append(req.Statuses, coredbservice.InstanceStatus(status))
+	}
+	for _, dbType := range splitQueryList(rest.GetStringQuery(r, "type")) {
+		req.Types = append(req.Types, coredbservice.DBType(strings.ToLower(dbType)))
+	}
+
+	if err := req.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	instances, total, err := h.dbService.ListInstances(r.Context(), user.ID, &req)
 	if err != nil {
 		rest.HandleError(w, err, h.logger)
 		return
@@ -129,7 +984,53 @@ func (h *Handler) ListInstances(w http.ResponseWriter, r *http.Request) {
 		res = append(res, *v.ToResponse())
 	}
 
-	rest.SendSuccessResponse(w, http.StatusOK, res)
+	rest.SendSuccessResponse(w, http.StatusOK, coredbservice.InstanceListResponse{
+		Data:       res,
+		Pagination: common.NewPaginationInfo(req.Page, req.Limit, total),
+	})
+}
+
+// splitQueryList "a,b,c" 형식 쿼리 값, 빈 항목은 무시
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (h *Handler) UpdateMetadata(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	var dto coredbservice.UpdateMetadataRequest
+	if !rest.DecodeJSONRequest(w, r, &dto, h.logger) {
+		return
+	}
+
+	if err := dto.Validate(); err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	instance, err := h.dbService.UpdateMetadata(r.Context(), user.ID, id, &dto)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, instance.ToResponse())
 }
 
 func (h *Handler) DeleteInstance(w http.ResponseWriter, r *http.Request) {
@@ -145,12 +1046,38 @@ func (h *Handler) DeleteInstance(w http.ResponseWriter, r *http.Request) {
 		return
 
 	}
-	if err := h.dbService.DeleteInstance(r.Context(), user.ID, id); err != nil {
+
+	// 기본은 휴지통 이동, ?permanent=true 면 보관 없이 바로 정리
+	permanent := rest.GetBoolQuery(r, "permanent", false)
+	deleteJob, err := h.dbService.DeleteInstance(r.Context(), user.ID, id, permanent)
+	if err != nil {
 		rest.HandleError(w, err, h.logger)
 		return
 	}
 
-	rest.SendSuccessResponse(w, http.StatusNoContent, nil)
+	rest.SendSuccessResponse(w, http.StatusAccepted, deleteJob.ToResponse())
+}
+
+func (h *Handler) UndeleteInstance(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	instance, err := h.dbService.UndeleteInstance(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, instance.ToResponse())
 }
 
 func (h *Handler) UpdateInstanceStatus(w http.ResponseWriter, r *http.Request) {
@@ -183,12 +1110,22 @@ func (h *Handler) UpdateInstanceStatus(w http.ResponseWriter, r *http.Request) {
 			rest.HandleError(w, err, h.logger)
 			return
 		}
+	case "resume":
+		// 유휴 자동 중지 알림 메일의 재개 버튼
+		if err := h.dbService.ResumeInstance(r.Context(), user.ID, id); err != nil {
+			rest.HandleError(w, err, h.logger)
+			return
+		}
 
 	case "restart":
-		if err := h.dbService.RestartInstance(r.Context(), user.ID, id); err != nil {
+		// 중지 후 시작까지 기다리지 않고 작업으로 실행
+		restartJob, err := h.dbService.RestartInstance(r.Context(), user.ID, id)
+		if err != nil {
 			rest.HandleError(w, err, h.logger)
 			return
 		}
+		rest.SendSuccessResponse(w, http.StatusAccepted, restartJob.ToResponse())
+		return
 	}
 
 	rest.SendSuccessResponse(w, http.StatusNoContent, nil)
@@ -0,0 +1,152 @@
+// Package sampledata 프리셋/인스턴스 생성 시 적재할 수 있는 학습용 샘플 데이터
+package sampledata
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+//go:embed data/*
+var files embed.FS
+
+type dataset struct {
+	dbservice.SampleDataset
+	file string
+}
+
+var datasets = []dataset{
+	{
+		SampleDataset: dbservice.SampleDataset{
+			ID:          "movies",
+			Type:        dbservice.MongoDB,
+			Name:        "영화 컬렉션",
+			Description: "영화 40편의 장르, 감독, 출연진, 평점 (중첩 문서와 배열 연습)",
+			Format:      dbservice.ImportFormatJSON,
+			Database:    "sample",
+			Collection:  "movies",
+			Examples: []string{
+				`db.movies.find({ genres: "Sci-Fi" }).sort({ "rating.imdb": -1 })`,
+				`db.movies.find({ countries: "South Korea", year: { $gte: 2010 } })`,
+				`db.movies.aggregate([{ $unwind: "$directors" }, { $group: { _id: "$directors", count: { $sum: 1 } } }, { $sort: { count: -1 } }])`,
+			},
+		},
+		file: "data/movies.json",
+	},
+	{
+		SampleDataset: dbservice.SampleDataset{
+			ID:          "ecommerce-orders",
+			Type:        dbservice.MongoDB,
+			Name:        "쇼핑몰 주문",
+			Description: "고객과 주문 상품이 포함된 주문 80건 (집계 파이프라인 연습)",
+			Format:      dbservice.ImportFormatJSON,
+			Database:    "shop",
+			Collection:  "orders",
+			Examples: []string{
+				`db.orders.find({ status: "delivered", "customer.city": "Seoul" })`,
+				`db.orders.aggregate([{ $unwind: "$items" }, { $group: { _id: "$items.category", revenue: { $sum: { $multiply: ["$items.price", "$items.qty"] } } } }])`,
+				`db.orders.createIndex({ "customer.id": 1, createdAt: -1 })`,
+			},
+		},
+		file: "data/ecommerce-orders.json",
+	},
+	{
+		SampleDataset: dbservice.SampleDataset{
+			ID:          "leaderboard",
+			Type:        dbservice.Redis,
+			Name:        "게임 리더보드",
+			Description: "플레이어 20명의 주간/전체 점수 (sorted set, hash)",
+			Format:      dbservice.ImportFormatRESP,
+			Examples: []string{
+				"ZREVRANGE leaderboard:weekly 0 9 WITHSCORES",
+				"ZREVRANK leaderboard:alltime player:7",
+				"HGETALL player:7",
+			},
+		},
+		file: "data/leaderboard.redis",
+	},
+	{
+		SampleDataset: dbservice.SampleDataset{
+			ID:          "session-cache",
+			Type:        dbservice.Redis,
+			Name:        "세션 캐시",
+			Description: "TTL 이 걸린 로그인 세션과 최근 본 상품 목록 (hash, set, list, 만료)",
+			Format:      dbservice.ImportFormatRESP,
+			Examples: []string{
+				"SCAN 0 MATCH session:* COUNT 100",
+				"SMEMBERS user:u001:sessions",
+				"LRANGE user:u001:recently-viewed 0 9",
+			},
+		},
+		file: "data/session-cache.redis",
+	},
+}
+
+// Lookup id 에 해당하는 데이터셋, 없으면 nil
+func Lookup(id string) *dbservice.SampleDataset {
+	for i := range datasets {
+		if datasets[i].ID == id {
+			ds := datasets[i].SampleDataset
+			return &ds
+		}
+	}
+	return nil
+}
+
+// List dbType 의 데이터셋 목록, dbType 이 비어 있으면 전체
+func List(dbType dbservice.DBType) []*dbservice.SampleDataset {
+	result := make([]*dbservice.SampleDataset, 0, len(datasets))
+	for i := range datasets {
+		if dbType != "" && datasets[i].Type != dbType {
+			continue
+		}
+		ds := datasets[i].SampleDataset
+		result = append(result, &ds)
+	}
+	return result
+}
+
+// Open import Job 이 바로 적재할 수 있는 형식으로 데이터셋 내용 반환
+func Open(id string) (io.Reader, error) {
+	for _, ds := range datasets {
+		if ds.ID != id {
+			continue
+		}
+		content, err := files.ReadFile(ds.file)
+		if err != nil {
+			return nil, fmt.Errorf("read sample dataset %s: %w", id, err)
+		}
+		if ds.Format == dbservice.ImportFormatRESP {
+			return toRESP(content)
+		}
+		return bytes.NewReader(content), nil
+	}
+	return nil, fmt.Errorf("unknown sample dataset %s", id)
+}
+
+// toRESP 한 줄에 명령 하나인 텍스트를 redis-cli --pipe 용 RESP 로 변환
+// 인자는 공백으로 구분하며 따옴표는 지원하지 않음, # 으로 시작하는 줄은 주석
+func toRESP(content []byte) (io.Reader, error) {
+	var buf bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args := strings.Fields(line)
+		fmt.Fprintf(&buf, "*%d\r\n", len(args))
+		for _, arg := range args {
+			fmt.Fprintf(&buf, "$%d\r\n%s\r\n", len(arg), arg)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
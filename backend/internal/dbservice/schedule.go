@@ -0,0 +1,226 @@
+package dbservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/utils/cron"
+)
+
+func (s *service) GetSchedule(ctx context.Context, userID, instanceID string) (*dbservice.PowerSchedule, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	schedule, err := s.dbiStore.FindSchedule(ctx, instance.ID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if schedule == nil {
+		return nil, errors.NewResourceNotFoundError("schedule", instanceID)
+	}
+	return schedule, nil
+}
+
+// UpdateSchedule 일정 교체, 다음 실행 예정을 바로 계산해 저장
+func (s *service) UpdateSchedule(ctx context.Context, userID, instanceID string, req *dbservice.UpdateScheduleRequest) (*dbservice.PowerSchedule, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	schedule := &dbservice.PowerSchedule{
+		InstanceID:         instance.ID,
+		InstanceExternalID: instance.ExternalID,
+		UserID:             instance.UserID,
+		Timezone:           req.Timezone,
+		StartCron:          req.StartCron,
+		StopCron:           req.StopCron,
+		Enabled:            *req.Enabled,
+	}
+
+	next, err := nextScheduledAction(schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if next == nil {
+		// 예: "0 0 30 2 *"
+		return nil, errors.NewInvalidParameterError("request", "지정한 일정으로 실행되는 시각이 없습니다")
+	}
+	if schedule.Enabled {
+		schedule.NextAction = next.Action
+		schedule.NextActionAt = &next.At
+	}
+
+	if err := s.dbiStore.UpsertSchedule(ctx, schedule); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 자동 시작/중지 일정 변경: start=%q stop=%q tz=%s enabled=%t",
+		instance.ExternalID, schedule.StartCron, schedule.StopCron, schedule.Timezone, schedule.Enabled)
+
+	// 이전 실행 기록까지 포함해 반환
+	saved, err := s.dbiStore.FindSchedule(ctx, instance.ID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if saved == nil {
+		return schedule, nil
+	}
+	return saved, nil
+}
+
+func (s *service) DeleteSchedule(ctx context.Context, userID, instanceID string) error {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	if err := s.dbiStore.DeleteSchedule(ctx, instance.ID); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// RunDueSchedules 서버가 멈춰 있던 동안 놓친 실행은 한번만 실행하고, 다음 예정은 지금 기준으로 다시 계산
+func (s *service) RunDueSchedules(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+	schedules, err := s.dbiStore.ListDueSchedules(ctx, now, limit)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+
+	executed := 0
+	for _, schedule := range schedules {
+		if s.runSchedule(ctx, schedule, now) {
+			executed++
+		}
+	}
+	return executed, nil
+}
+
+func (s *service) runSchedule(ctx context.Context, schedule *dbservice.PowerSchedule, now time.Time) bool {
+	action := schedule.NextAction
+
+	var err error
+	switch action {
+	case dbservice.PowerActionStart:
+		err = s.StartInstance(ctx, schedule.UserID, schedule.InstanceExternalID)
+	case dbservice.PowerActionStop:
+		err = s.StopInstance(ctx, schedule.UserID, schedule.InstanceExternalID)
+	default:
+		err = fmt.Errorf("unknown scheduled action: %q", action)
+	}
+
+	executed := false
+	var domainErr errors.DomainError
+	switch {
+	case err == nil:
+		schedule.LastAction = action
+		schedule.LastActionAt = &now
+		executed = true
+		s.logger.Printf("인스턴스 %s 예약된 %s 실행", schedule.InstanceExternalID, action)
+	case errors.As(err, &domainErr) && domainErr.Code() == errors.ErrInsufficientLemons:
+		skipScheduledRun(schedule, now, dbservice.SkipInsufficientLemons, err)
+		s.logger.Printf("인스턴스 %s 예약된 %s 건너뜀 (레몬 부족)", schedule.InstanceExternalID, action)
+	case errors.As(err, &domainErr) && domainErr.Code() == errors.ErrInvalidStatusTransition:
+		// 이미 목표 상태면 할 일이 없었던 것, 건너뜀으로 기록하지 않음
+		if data, ok := domainErr.ErrorData().(map[string]string); !ok || data["current"] != data["target"] {
+			skipScheduledRun(schedule, now, dbservice.SkipInvalidStatus, err)
+			s.logger.Printf("인스턴스 %s 예약된 %s 건너뜀: %v", schedule.InstanceExternalID, action, err)
+		}
+	default:
+		skipScheduledRun(schedule, now, dbservice.SkipFailed, err)
+		s.logger.Printf("인스턴스 %s 예약된 %s 실패: %v", schedule.InstanceExternalID, action, err)
+	}
+
+	schedule.NextAction, schedule.NextActionAt = "", nil
+	next, err := nextScheduledAction(schedule, now)
+	if err != nil {
+		// 저장할 때 검증하므로 여기서는 실패하지 않아야 함, 다시 돌지 않도록 예정만 비움
+		s.logger.Printf("인스턴스 %s 다음 예약 계산 실패: %v", schedule.InstanceExternalID, err)
+	} else if next != nil {
+		schedule.NextAction = next.Action
+		schedule.NextActionAt = &next.At
+	}
+
+	if err := s.dbiStore.RecordScheduleRun(ctx, schedule); err != nil {
+		s.logger.Printf("인스턴스 %s 예약 실행 기록 실패: %v", schedule.InstanceExternalID, err)
+	}
+	return executed
+}
+
+func skipScheduledRun(schedule *dbservice.PowerSchedule, now time.Time, reason dbservice.ScheduleSkipReason, err error) {
+	schedule.LastSkippedAt = &now
+	schedule.LastSkipReason = reason
+	schedule.LastSkipDetail = fmt.Sprintf("%s: %v", schedule.NextAction, err)
+}
+
+// nextScheduledAction after 이후 가장 먼저 오는 시작/중지, 없으면 nil
+// 같은 시각이면 중지가 우선 (비용이 나가지 않는 쪽)
+func nextScheduledAction(schedule *dbservice.PowerSchedule, after time.Time) (*dbservice.ScheduledAction, error) {
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, errors.NewInvalidParameterError("timezone",
+			fmt.Sprintf("알 수 없는 시간대입니다: %s", schedule.Timezone))
+	}
+
+	candidates := []struct {
+		field  string
+		action dbservice.PowerAction
+		expr   string
+	}{
+		{"stopCron", dbservice.PowerActionStop, schedule.StopCron},
+		{"startCron", dbservice.PowerActionStart, schedule.StartCron},
+	}
+
+	var next *dbservice.ScheduledAction
+	for _, c := range candidates {
+		if c.expr == "" {
+			continue
+		}
+		expr, err := cron.Parse(c.expr)
+		if err != nil {
+			return nil, errors.NewInvalidParameterError(c.field,
+				fmt.Sprintf("올바른 cron 형식이 아닙니다: %v", err))
+		}
+		at := expr.Next(after.In(loc))
+		if at.IsZero() {
+			continue
+		}
+		if next == nil || at.Before(next.At) {
+			next = &dbservice.ScheduledAction{Action: c.action, At: at}
+		}
+	}
+	return next, nil
+}
+
+// attachSchedules 목록 응답에 일정 정보 추가
+func (s *service) attachSchedules(ctx context.Context, userID string, instances []*dbservice.DBInstance) error {
+	schedules, err := s.dbiStore.ListSchedules(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	byInstance := make(map[int64]*dbservice.PowerSchedule, len(schedules))
+	for _, schedule := range schedules {
+		byInstance[schedule.InstanceID] = schedule
+	}
+	for _, instance := range instances {
+		instance.Schedule = byInstance[instance.ID]
+	}
+	return nil
+}
@@ -2,14 +2,21 @@ package dbservice
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"fmt"
 	"github.com/piper-hyowon/dBtree/internal/core/resource"
 	"github.com/piper-hyowon/dBtree/internal/utils/crypto"
+	"io"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"log"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,30 +24,44 @@ import (
 
 	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
 	"github.com/piper-hyowon/dBtree/internal/core/lemon"
 	"github.com/piper-hyowon/dBtree/internal/core/user"
+	"github.com/piper-hyowon/dBtree/internal/dbservice/sampledata"
 	"github.com/piper-hyowon/dBtree/internal/platform/k8s"
 )
 
+// rootUsername 인스턴스 생성 시 만드는 관리자 계정
+const rootUsername = "admin"
+
 type service struct {
-	publicDBHost    string
+	access          dbservice.ExternalAccess
 	dbiStore        dbservice.DBInstanceStore
 	lemonService    lemon.Service
 	presetStore     dbservice.PresetStore
 	userStore       user.Store
 	k8sClient       k8s.Client
 	portStore       dbservice.PortStore
+	sagaStore       dbservice.ProvisionSagaStore
+	jobQueue        job.Queue
 	resourceManager resource.Manager
+	importStager    *ImportStager
+	backupSigner    *BackupURLSigner
+	deleteRetention time.Duration
 	logger          *log.Logger
 }
 
-func (s *service) ListInstances(ctx context.Context, userID string) ([]*dbservice.DBInstance, error) {
-	instances, err := s.dbiStore.List(ctx, userID)
+func (s *service) ListInstances(ctx context.Context, userID string, req *dbservice.ListInstancesRequest) ([]*dbservice.DBInstance, int, error) {
+	instances, total, err := s.dbiStore.Search(ctx, userID, req)
 	if err != nil {
-		return nil, errors.Wrap(err)
+		return nil, 0, errors.Wrap(err)
+	}
+
+	if err := s.attachSchedules(ctx, userID, instances); err != nil {
+		s.logger.Printf("자동 시작/중지 일정 조회 실패: %v", err)
 	}
 
-	return instances, nil
+	return instances, total, nil
 }
 
 func (s *service) mapInfraStatusToInstanceStatus(status *k8s.MongoDBStatus) dbservice.InstanceStatus {
@@ -76,7 +97,7 @@ func (s *service) GetInstanceWithSync(ctx context.Context, userID, id string) (*
 
 	// K8s와 상태 동기화 (MongoDB만 지원하므로 타입 체크)
 	if instance.K8sNamespace != "" && instance.K8sResourceName != "" && instance.Type == dbservice.MongoDB {
-		// DBInstance CRD 가져오기
+		// DBInstance CRD 가져오기 (informer 캐시가 동기화되어 있으면 API 호출 없음)
 		crd, err := s.k8sClient.DBInstance(ctx, instance.K8sNamespace, instance.K8sResourceName)
 		if err != nil {
 			s.logger.Printf("Failed to get DBInstance CRD: %v", err)
@@ -92,125 +113,1017 @@ func (s *service) GetInstanceWithSync(ctx context.Context, userID, id string) (*
 					s.logger.Printf("Status mismatch - DB: %s, K8s: %s. Updating DB...",
 						instance.Status, k8sStatus)
 
-					reason, _, _ := unstructured.NestedString(crd.Object, "status", "statusReason")
+					reason, _, _ := unstructured.NestedString(crd.Object, "status", "statusReason")
+
+					if err := s.dbiStore.UpdateStatus(ctx, instance.ID, k8sStatus, reason); err != nil {
+						s.logger.Printf("Failed to update status in DB: %v", err)
+					} else {
+						instance.Status = k8sStatus
+						instance.StatusReason = reason
+					}
+				}
+			}
+		}
+
+		// MongoDB 상태 확인 (provisioning 등)
+		if instance.Status == dbservice.StatusProvisioning {
+			status, err := s.k8sClient.GetMongoDBStatus(ctx, instance.K8sNamespace, instance.K8sResourceName)
+			if err != nil {
+				s.logger.Printf("Failed to get MongoDB status: %v", err)
+			} else {
+				s.logger.Printf("K8s status - Phase: %s, Ready: %v", status.Phase, status.Ready)
+
+				// 상태 업데이트
+				newStatus := s.mapMongoDBStatus(status)
+				s.logger.Printf("Mapped status: %s", newStatus)
+
+				if newStatus != instance.Status {
+					if err := s.dbiStore.UpdateStatus(ctx, instance.ID, newStatus, status.Message); err != nil {
+						s.logger.Printf("Failed to update status in DB: %v", err)
+					} else {
+						instance.Status = newStatus
+						instance.StatusReason = status.Message
+					}
+				}
+
+				// 연결 정보 업데이트
+				if status.Ready && instance.Endpoint == "" {
+					instance.Endpoint = status.Endpoint
+					instance.Port = int(status.Port)
+					_ = s.dbiStore.Update(ctx, instance)
+				}
+			}
+		}
+	}
+
+	schedule, err := s.dbiStore.FindSchedule(ctx, instance.ID)
+	if err != nil {
+		s.logger.Printf("자동 시작/중지 일정 조회 실패 (%s): %v", instance.ExternalID, err)
+	}
+	instance.Schedule = schedule
+
+	return instance, nil
+}
+
+func (s *service) ListInstanceEvents(ctx context.Context, userID, instanceID string) ([]*dbservice.InstanceEvent, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	events := make([]*dbservice.InstanceEvent, 0)
+	if instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return events, nil
+	}
+
+	history, err := s.k8sClient.DBInstanceHistory(ctx, instance.K8sNamespace, instance.K8sResourceName)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// 최신 이벤트가 먼저 오도록 역순 정렬
+	for i := len(history) - 1; i >= 0; i-- {
+		events = append(events, &dbservice.InstanceEvent{
+			Time:    history[i].Time,
+			State:   dbservice.InstanceStatus(history[i].State),
+			Type:    history[i].Type,
+			Reason:  history[i].Reason,
+			Message: history[i].Message,
+		})
+	}
+
+	return events, nil
+}
+
+func (s *service) StreamInstanceLogs(ctx context.Context, userID, instanceID string, req *dbservice.InstanceLogsRequest) (io.ReadCloser, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	if instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	pods, err := s.k8sClient.InstancePods(ctx, instance.K8sNamespace, instance.K8sResourceName)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if len(pods) == 0 {
+		// 정지됐거나 아직 파드가 스케줄되지 않음
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	pod := pods[0]
+	if req.Pod != "" {
+		// 같은 네임스페이스의 다른 리소스 로그는 조회 불가
+		if !slices.Contains(pods, req.Pod) {
+			return nil, errors.NewResourceNotFoundError("pod", req.Pod)
+		}
+		pod = req.Pod
+	}
+
+	tail := int64(req.Tail)
+	return s.k8sClient.StreamPodLogs(ctx, instance.K8sNamespace, pod, k8s.PodLogOptions{
+		Container: string(instance.Type), // 컨테이너 이름은 엔진 타입과 동일
+		Follow:    req.Follow,
+		Since:     req.Since,
+		TailLines: &tail,
+		Previous:  req.Previous,
+	})
+}
+
+func (s *service) GetAllowlist(ctx context.Context, userID, instanceID string) (*dbservice.AllowlistResponse, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	return s.allowlistResponse(ctx, instance)
+}
+
+// UpdateAllowlist 허용 CIDR 목록 교체
+// DB 에 저장 후 CRD spec 을 패치하면 오퍼레이터가 게이트웨이 미들웨어와 NetworkPolicy 에 반영함
+func (s *service) UpdateAllowlist(ctx context.Context, userID, instanceID string, req *dbservice.UpdateAllowlistRequest) (*dbservice.AllowlistResponse, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	if err := s.dbiStore.UpdateAllowedCIDRs(ctx, instance.ID, req.CIDRs); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	instance.AllowedCIDRs = req.CIDRs
+
+	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
+		if err := s.k8sClient.SetAllowedSourceRanges(ctx, instance.K8sNamespace, instance.K8sResourceName, req.CIDRs); err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+
+	s.logger.Printf("인스턴스 %s 허용 CIDR 변경: %v", instance.ExternalID, req.CIDRs)
+	return s.allowlistResponse(ctx, instance)
+}
+
+func (s *service) allowlistResponse(ctx context.Context, instance *dbservice.DBInstance) (*dbservice.AllowlistResponse, error) {
+	resp := &dbservice.AllowlistResponse{
+		CIDRs:        instance.AllowedCIDRs,
+		AppliedCIDRs: []string{},
+	}
+	if resp.CIDRs == nil {
+		resp.CIDRs = []string{}
+	}
+
+	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
+		status, err := s.k8sClient.SourceRangeStatus(ctx, instance.K8sNamespace, instance.K8sResourceName)
+		if err != nil {
+			// 적용 상태를 모르면 대기 중으로 표시
+			s.logger.Printf("허용 CIDR 적용 상태 조회 실패 (%s): %v", instance.ExternalID, err)
+		} else {
+			resp.AppliedCIDRs = status.Applied
+			resp.AppliedAt = status.AppliedAt
+		}
+	}
+
+	resp.Pending = !slices.Equal(resp.CIDRs, resp.AppliedCIDRs)
+	return resp, nil
+}
+
+// UpdateProfiling 느린 작업 수집 설정 변경
+// 오퍼레이터가 설정 파일을 다시 만들어 롤링 재시작하므로 적용까지 시간이 걸림
+func (s *service) UpdateProfiling(ctx context.Context, userID, instanceID string, req *dbservice.UpdateProfilingRequest) (*dbservice.ProfilingConfig, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	profiling := &dbservice.ProfilingConfig{
+		Enabled:         req.Enabled,
+		SlowThresholdMs: req.SlowThresholdMs,
+	}
+	if err := s.dbiStore.UpdateProfiling(ctx, instance.ID, profiling); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
+		if err := s.k8sClient.SetProfiling(ctx, instance.K8sNamespace, instance.K8sResourceName, *profilingSpec(profiling)); err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+
+	s.logger.Printf("인스턴스 %s 프로파일링 변경: enabled=%t threshold=%dms",
+		instance.ExternalID, profiling.Enabled, profiling.SlowThresholdMs)
+	return profiling, nil
+}
+
+// SlowQueries 느린 작업을 쿼리 형태별로 묶어 총 소요 시간 상위 목록 반환
+// 엔진 클라이언트 파드로 system.profile / SLOWLOG 를 읽어옴
+func (s *service) SlowQueries(ctx context.Context, userID, instanceID string, req *dbservice.SlowQueryRequest) (*dbservice.SlowQueryReport, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	report := &dbservice.SlowQueryReport{
+		Since:      time.Now().Add(-req.Window),
+		TopQueries: []*dbservice.QueryShapeStats{},
+	}
+	if instance.Profiling != nil {
+		report.Profiling = *instance.Profiling
+	}
+	if !report.Profiling.Enabled {
+		return report, nil
+	}
+
+	if instance.Status != dbservice.StatusRunning || instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	engine, ok := dbservice.LookupEngine(instance.Type)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", instance.Type))
+	}
+	profiler, ok := engine.(dbservice.Profiler)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("%s 는 느린 작업 조회를 지원하지 않습니다", instance.Type))
+	}
+
+	output, err := s.runClientCommand(ctx, instance, engine, "slowlog", profiler.SlowLogCommand(slowLogFetchLimit))
+	if err != nil {
+		return nil, err // CommandFailed/CommandTimeout 은 그대로 전달
+	}
+
+	ops, err := profiler.ParseSlowLog(output)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	for _, op := range ops {
+		if !op.Time.Before(report.Since) {
+			report.Sampled++
+		}
+	}
+	report.TopQueries = dbservice.SummarizeSlowOperations(ops, report.Since, req.Limit)
+	return report, nil
+}
+
+// RunConsoleCommand 읽기 전용 콘솔 명령 실행
+// 접속 정보는 클러스터 안에서만 쓰이고 브라우저에는 결과만 전달됨
+func (s *service) RunConsoleCommand(ctx context.Context, userID, instanceID string, req *dbservice.ConsoleRequest) (*dbservice.ConsoleResult, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	if instance.Status != dbservice.StatusRunning || instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	engine, ok := dbservice.LookupEngine(instance.Type)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", instance.Type))
+	}
+	console, ok := engine.(dbservice.Console)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("%s 는 콘솔을 지원하지 않습니다", instance.Type))
+	}
+
+	command, err := console.ConsoleCommand(req)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	started := time.Now()
+	output, err := s.k8sClient.RunCommandPod(ctx, instance.K8sNamespace, k8s.CommandPodSpec{
+		NamePrefix: instance.K8sResourceName + "-console",
+		Image:      engine.ClientImage(),
+		Command:    command,
+		Env: map[string]string{
+			"DB_HOST":                   instance.K8sResourceName + "-svc",
+			"DB_PORT":                   strconv.Itoa(engine.DefaultPort()),
+			dbservice.ConsoleRequestEnv: string(payload),
+		},
+		SecretName:     instance.K8sSecretRef,
+		Timeout:        dbservice.ConsoleTimeout,
+		MaxOutputBytes: consoleOutputLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output) >= consoleOutputLimit {
+		// 로그가 잘려 JSON 으로 해석할 수 없음
+		return nil, errors.NewResultTooLargeError(dbservice.MaxConsoleResultBytes)
+	}
+
+	result, err := console.ParseConsoleOutput(req, output)
+	if err != nil {
+		return nil, err
+	}
+	result.ElapsedMs = time.Since(started).Milliseconds()
+
+	s.logger.Printf("인스턴스 %s 콘솔 명령 실행: %s (%dms)", instance.ExternalID, result.Command, result.ElapsedMs)
+	return result, nil
+}
+
+// consoleOutputLimit 엔진이 결과를 자르고 남은 JSON 래퍼 여유분 포함
+const consoleOutputLimit = dbservice.MaxConsoleResultBytes + 16*1024
+
+// slowLogFetchLimit 한 번에 읽어오는 느린 작업 수 (MongoDB 는 DB 별)
+const slowLogFetchLimit = 1000
+
+// runClientCommand 인스턴스 네임스페이스에서 엔진 클라이언트로 명령 실행
+// 접속 정보는 Secret 을 환경 변수로 주입하므로 명령에 비밀번호가 남지 않음
+func (s *service) runClientCommand(ctx context.Context, instance *dbservice.DBInstance, engine dbservice.Engine, purpose string, command []string) ([]byte, error) {
+	return s.k8sClient.RunCommandPod(ctx, instance.K8sNamespace, k8s.CommandPodSpec{
+		NamePrefix: instance.K8sResourceName + "-" + purpose,
+		Image:      engine.ClientImage(),
+		Command:    command,
+		Env: map[string]string{
+			"DB_HOST": instance.K8sResourceName + "-svc",
+			"DB_PORT": strconv.Itoa(engine.DefaultPort()),
+		},
+		SecretName: instance.K8sSecretRef,
+	})
+}
+
+func profilingSpec(profiling *dbservice.ProfilingConfig) *k8s.ProfilingSpec {
+	if profiling == nil {
+		return nil
+	}
+	return &k8s.ProfilingSpec{
+		Enabled:           profiling.Enabled,
+		SlowOpThresholdMs: profiling.SlowThresholdMs,
+	}
+}
+
+// StartImport 업로드 파일로 데이터 가져오기
+// 파일은 백엔드에 임시 보관되고, 오퍼레이터 import Job 이 일회용 토큰으로 내려받아 적재함
+func (s *service) StartImport(ctx context.Context, userID, instanceID string, req *dbservice.StartImportRequest, body io.Reader) (*dbservice.DataImport, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	if instance.Status != dbservice.StatusRunning || instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	engine, ok := dbservice.LookupEngine(instance.Type)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", instance.Type))
+	}
+	importer, ok := engine.(dbservice.Importer)
+	if !ok {
+		return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("%s 는 데이터 가져오기를 지원하지 않습니다", instance.Type))
+	}
+	if err := importer.ValidateImport(req); err != nil {
+		return nil, err
+	}
+
+	current, err := s.k8sClient.DataImportStatus(ctx, instance.K8sNamespace, instance.K8sResourceName)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if current.InProgress() {
+		return nil, errors.NewImportInProgressError()
+	}
+
+	token, err := crypto.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &dbservice.DataImport{
+		ID:           uuid.New().String(),
+		InstanceID:   instance.ID,
+		Format:       req.Format,
+		Database:     req.Database,
+		Collection:   req.Collection,
+		Drop:         req.Drop,
+		Status:       dbservice.ImportStatusPending,
+		StagingToken: token,
+	}
+
+	imp.SizeBytes, err = s.importStager.Save(imp.ID, body, dbservice.MaxImportBytes(instance.Resources))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dbiStore.CreateImport(ctx, imp); err != nil {
+		s.removeStagedImport(imp.ID)
+		return nil, errors.Wrap(err)
+	}
+
+	if err := s.k8sClient.StartDataImport(ctx, instance.K8sNamespace, instance.K8sResourceName, k8s.DataImportSpec{
+		ID:         imp.ID,
+		Format:     string(imp.Format),
+		SourceURL:  s.importStager.SourceURL(imp.ID, token),
+		Database:   imp.Database,
+		Collection: imp.Collection,
+		Drop:       imp.Drop,
+	}); err != nil {
+		if markErr := s.dbiStore.UpdateImportStatus(ctx, imp.ID, dbservice.ImportStatusFailed, "import 요청 실패"); markErr != nil {
+			s.logger.Printf("가져오기 실패 기록 실패 (%s): %v", imp.ID, markErr)
+		}
+		s.removeStagedImport(imp.ID)
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 데이터 가져오기 요청: %s (%s, %d bytes)",
+		instance.ExternalID, imp.ID, imp.Format, imp.SizeBytes)
+	return imp, nil
+}
+
+func (s *service) ListImports(ctx context.Context, userID, instanceID string) ([]*dbservice.DataImport, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	imports, err := s.dbiStore.ListImports(ctx, instance.ID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return imports, nil
+}
+
+func (s *service) GetImport(ctx context.Context, userID, instanceID, importID string) (*dbservice.DataImport, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	imp, err := s.dbiStore.FindImport(ctx, importID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if imp == nil || imp.InstanceID != instance.ID {
+		return nil, errors.NewResourceNotFoundError("import", importID)
+	}
+	return imp, nil
+}
+
+// OpenImportPayload 토큰이 틀려도 NotFound 로 응답해 요청 존재 여부를 드러내지 않음
+func (s *service) OpenImportPayload(ctx context.Context, importID, token string) (io.ReadCloser, int64, error) {
+	imp, err := s.dbiStore.FindImport(ctx, importID)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+	if imp == nil || imp.Status.Finished() ||
+		subtle.ConstantTimeCompare([]byte(imp.StagingToken), []byte(token)) != 1 {
+		return nil, 0, errors.NewResourceNotFoundError("import", importID)
+	}
+
+	file, size, err := s.importStager.Open(imp.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if file == nil {
+		return nil, 0, errors.NewResourceNotFoundError("import", importID)
+	}
+	return file, size, nil
+}
+
+// IssueBackupDownload 소유자에게 짧은 시간만 유효한 백업 다운로드 주소 발급
+func (s *service) IssueBackupDownload(ctx context.Context, userID, instanceID, backupID string, client dbservice.ClientInfo) (*dbservice.BackupDownload, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	backup, err := s.dbiStore.FindBackup(ctx, backupID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if backup == nil || backup.InstanceID != instance.ID {
+		return nil, errors.NewResourceNotFoundError("backup", backupID)
+	}
+	if backup.Status != dbservice.BackupStatusCompleted || backup.StoragePath == "" {
+		return nil, errors.NewInvalidParameterError("backupId", "완료된 백업만 다운로드할 수 있습니다")
+	}
+
+	// 감사 기록을 남기지 못하면 발급하지 않음
+	if err := s.dbiStore.RecordBackupDownload(ctx, &dbservice.BackupDownloadAudit{
+		BackupID:  backup.ID,
+		UserID:    userID,
+		Action:    dbservice.BackupDownloadIssued,
+		ClientIP:  client.IP,
+		UserAgent: client.UserAgent,
+	}); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	return s.backupSigner.Sign(backup.ExternalID.String(), userID, time.Now()), nil
+}
+
+// OpenBackupDownload 서명된 주소로 백업 아카이브를 열기, 세션 대신 서명으로 소유자 확인
+func (s *service) OpenBackupDownload(ctx context.Context, signed *dbservice.SignedBackupURL, client dbservice.ClientInfo) (*dbservice.BackupArchive, error) {
+	if !s.backupSigner.Verify(signed, time.Now()) {
+		return nil, errors.NewInvalidDownloadLinkError()
+	}
+
+	backup, err := s.dbiStore.FindBackup(ctx, signed.BackupID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if backup == nil || backup.Status != dbservice.BackupStatusCompleted || backup.StoragePath == "" {
+		return nil, errors.NewResourceNotFoundError("backup", signed.BackupID)
+	}
+
+	// 발급 이후 인스턴스가 삭제되거나 소유자가 바뀐 경우도 거부
+	instance, err := s.dbiStore.FindByID(ctx, backup.InstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != signed.UserID || instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewResourceNotFoundError("backup", signed.BackupID)
+	}
+
+	if err := s.dbiStore.RecordBackupDownload(ctx, &dbservice.BackupDownloadAudit{
+		BackupID:  backup.ID,
+		UserID:    signed.UserID,
+		Action:    dbservice.BackupDownloadDownloaded,
+		ClientIP:  client.IP,
+		UserAgent: client.UserAgent,
+	}); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// 오퍼레이터는 백업 PVC 를 /backup 에 마운트해 아카이브를 씀
+	archive := strings.TrimPrefix(backup.StoragePath, "/backup/")
+	if archive == "" || strings.Contains(archive, "..") {
+		return nil, errors.NewResourceNotFoundError("backup", signed.BackupID)
+	}
+	body, size, err := s.k8sClient.OpenBackupArchive(ctx, instance.K8sNamespace, k8s.BackupPVCName(instance.K8sResourceName), archive)
+	if err != nil {
+		return nil, err
+	}
+	if backup.SizeBytes > 0 && size != backup.SizeBytes {
+		body.Close()
+		return nil, errors.NewInternalError(fmt.Errorf("backup %s archive is %d bytes, recorded %d",
+			backup.ExternalID, size, backup.SizeBytes))
+	}
+
+	s.logger.Printf("백업 %s 다운로드 시작 (user: %s, ip: %s)", backup.ExternalID, signed.UserID, client.IP)
+
+	return &dbservice.BackupArchive{
+		Body:      body,
+		FileName:  path.Base(archive),
+		SizeBytes: size,
+	}, nil
+}
+
+// ListSampleDatasets 생성 시 지정할 수 있는 샘플 데이터셋, dbType 이 비어 있으면 전체
+func (s *service) ListSampleDatasets(ctx context.Context, dbType dbservice.DBType) ([]*dbservice.SampleDataset, error) {
+	if dbType != "" {
+		if _, ok := dbservice.LookupEngine(dbType); !ok {
+			return nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", dbType))
+		}
+	}
+	return sampledata.List(dbType), nil
+}
+
+// lookupSampleDataset id 가 비어 있으면 nil
+func lookupSampleDataset(dbType dbservice.DBType, id string) (*dbservice.SampleDataset, error) {
+	if id == "" {
+		return nil, nil
+	}
+	dataset := sampledata.Lookup(id)
+	if dataset == nil {
+		return nil, errors.NewInvalidParameterError("sampleDataset", fmt.Sprintf("존재하지 않는 샘플 데이터셋: %s", id))
+	}
+	if dataset.Type != dbType {
+		return nil, errors.NewInvalidParameterError("sampleDataset",
+			fmt.Sprintf("%s 는 %s 용 샘플 데이터셋입니다", id, dataset.Type))
+	}
+	return dataset, nil
+}
+
+// stageSampleDataset 샘플 데이터셋을 import 로 등록하고 파일을 보관
+// 적재는 부가 기능이라 실패해도 인스턴스 생성은 계속 진행하고 nil 반환
+func (s *service) stageSampleDataset(ctx context.Context, instance *dbservice.DBInstance, dataset *dbservice.SampleDataset) *k8s.DataImportSpec {
+	token, err := crypto.GenerateRandomToken(32)
+	if err != nil {
+		s.logger.Printf("샘플 데이터셋 토큰 생성 실패 (%s): %v", instance.ExternalID, err)
+		return nil
+	}
+
+	body, err := sampledata.Open(dataset.ID)
+	if err != nil {
+		s.logger.Printf("샘플 데이터셋 열기 실패 (%s): %v", dataset.ID, err)
+		return nil
+	}
+
+	imp := &dbservice.DataImport{
+		ID:            uuid.New().String(),
+		InstanceID:    instance.ID,
+		Format:        dataset.Format,
+		Database:      dataset.Database,
+		Collection:    dataset.Collection,
+		Status:        dbservice.ImportStatusPending,
+		StagingToken:  token,
+		SampleDataset: dataset.ID,
+	}
+
+	imp.SizeBytes, err = s.importStager.Save(imp.ID, body, dbservice.MaxImportBytes(instance.Resources))
+	if err != nil {
+		s.logger.Printf("샘플 데이터셋 저장 실패 (%s): %v", instance.ExternalID, err)
+		return nil
+	}
+	if err := s.dbiStore.CreateImport(ctx, imp); err != nil {
+		s.logger.Printf("샘플 데이터셋 import 기록 실패 (%s): %v", instance.ExternalID, err)
+		s.removeStagedImport(imp.ID)
+		return nil
+	}
+
+	return &k8s.DataImportSpec{
+		ID:         imp.ID,
+		Format:     string(imp.Format),
+		SourceURL:  s.importStager.SourceURL(imp.ID, token),
+		Database:   imp.Database,
+		Collection: imp.Collection,
+	}
+}
+
+func (s *service) removeStagedImport(importID string) {
+	if err := s.importStager.Remove(importID); err != nil {
+		s.logger.Printf("가져오기 임시 파일 삭제 실패 (%s): %v", importID, err)
+	}
+}
+
+// RotateCredentials 루트 비밀번호 교체
+// 새 비밀번호는 Secret 의 pending-password 로 전달되고, 오퍼레이터가 파드 재시작 없이 적용한 뒤
+// password/connection-string 을 교체함. 새 접속 정보는 이 응답에서만 제공
+func (s *service) RotateCredentials(ctx context.Context, userID, instanceID string) (*dbservice.RotateCredentialsResponse, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	// 운영 중인 DB 에 바로 적용해야 하므로 running 상태에서만 허용
+	if instance.Status != dbservice.StatusRunning || instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	current, err := s.k8sClient.CredentialsRotationStatus(ctx, instance.K8sNamespace, instance.K8sResourceName)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if current.InProgress() {
+		return nil, errors.NewCredentialRotationInProgressError()
+	}
+
+	password, err := crypto.GenerateSecurePassword()
+	if err != nil {
+		return nil, errors.Wrapf(err, "비밀번호 생성 실패")
+	}
+
+	rotation := &dbservice.CredentialRotation{
+		ID:         uuid.New().String(),
+		InstanceID: instance.ID,
+		Status:     dbservice.CredentialRotationPending,
+	}
+	if err := s.dbiStore.CreateCredentialRotation(ctx, rotation); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	if err := s.k8sClient.RequestCredentialsRotation(ctx, instance.K8sNamespace, instance.K8sResourceName,
+		instance.K8sSecretRef, rotation.ID, password); err != nil {
+		if markErr := s.dbiStore.CompleteCredentialRotation(ctx, rotation.ID, nil); markErr != nil {
+			s.logger.Printf("비밀번호 교체 실패 기록 실패 (%s): %v", rotation.ID, markErr)
+		}
+		return nil, errors.Wrap(err)
+	}
+
+	s.logger.Printf("인스턴스 %s 비밀번호 교체 요청: %s", instance.ExternalID, rotation.ID)
+	return &dbservice.RotateCredentialsResponse{
+		Rotation:    rotation,
+		Credentials: s.buildCredentials(instance, rootUsername, password),
+	}, nil
+}
+
+func (s *service) ListPresets(ctx context.Context) ([]*dbservice.DBPreset, error) {
+	var presets []*dbservice.DBPreset
+	for _, engine := range dbservice.AvailableEngines() {
+		typePresets, err := s.presetStore.ListByType(ctx, engine.Type())
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		presets = append(presets, typePresets...)
+	}
+
+	return presets, nil
+}
+
+func (s *service) mapMongoDBStatus(status *k8s.MongoDBStatus) dbservice.InstanceStatus {
+	switch status.Phase {
+	case "running":
+		if status.Ready {
+			return dbservice.StatusRunning
+		}
+		return dbservice.StatusProvisioning
+	case "failed", "error":
+		return dbservice.StatusError
+	case "pending", "provisioning":
+		return dbservice.StatusProvisioning
+	default:
+		s.logger.Printf("Unknown phase: %s", status.Phase) // 디버깅용
+		return dbservice.StatusProvisioning
+	}
+}
+
+// UpdateInstance 리소스/설정/백업 설정 변경
+// CRD spec 을 갱신하면 오퍼레이터가 StatefulSet, ConfigMap, 백업 CronJob 에 반영함
+// 시간당 비용이 바뀌면 이력을 남기고 다음 과금 주기부터 새 비용으로 과금
+func (s *service) UpdateInstance(ctx context.Context, userID, instanceID string, req *dbservice.UpdateInstanceRequest) (*dbservice.DBInstance, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	// 중지된 인스턴스는 CRD 만 바꿔 두고 시작할 때 반영
+	if instance.Status != dbservice.StatusRunning && instance.Status != dbservice.StatusStopped {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+	if instance.K8sNamespace == "" || instance.K8sResourceName == "" {
+		return nil, errors.NewInstanceNotReadyError(string(instance.Status))
+	}
+
+	updated := *instance
+
+	if req.Resources != nil {
+		// 디스크는 StatefulSet volumeClaimTemplates 라 변경 불가, 생략하면 현재 값 유지
+		if req.Resources.Disk != 0 && req.Resources.Disk != instance.Resources.Disk {
+			return nil, errors.NewInvalidParameterError("resources.disk", "디스크 크기는 변경할 수 없습니다")
+		}
+		updated.Resources = *req.Resources
+		updated.Resources.Disk = instance.Resources.Disk
+		updated.Size = updated.Resources.CalculateSize()
+		updated.Cost.HourlyLemons = dbservice.CalculateCustomCost(instance.Type, updated.Resources).HourlyLemons
+	}
+
+	updated.Config = make(map[string]interface{}, len(instance.Config)+len(req.Config))
+	for k, v := range instance.Config {
+		updated.Config[k] = v
+	}
+	for k, v := range req.Config {
+		updated.Config[k] = v
+	}
+	// 리소스만 바꿔도 기존 설정(maxmemory 등)이 새 리소스에 맞는지 확인
+	if err := dbservice.NewConfigValidator().ValidateConfig(instance.Type, instance.Mode, updated.Config, &updated.Resources); err != nil {
+		return nil, err
+	}
+
+	if req.BackupEnabled != nil {
+		updated.BackupConfig.Enabled = *req.BackupEnabled
+	}
+	if req.BackupSchedule != nil {
+		updated.BackupConfig.Schedule = *req.BackupSchedule
+	}
+	if req.BackupRetentionDays != nil {
+		updated.BackupConfig.RetentionDays = *req.BackupRetentionDays
+	}
+	if updated.BackupConfig.Enabled {
+		if updated.BackupConfig.Schedule == "" {
+			return nil, errors.NewInvalidParameterError("backupSchedule",
+				"백업이 활성화되면 백업 스케줄을 지정해야 합니다")
+		}
+		if updated.BackupConfig.RetentionDays == 0 {
+			updated.BackupConfig.RetentionDays = 7
+		}
+	}
+
+	// 실행 중이면 늘어나는 만큼만 여유 리소스 확인
+	if instance.Status == dbservice.StatusRunning {
+		extra := resource.SystemResourceSpec{
+			CPU:    max(updated.Resources.CPU-instance.Resources.CPU, 0),
+			Memory: max(updated.Resources.Memory-instance.Resources.Memory, 0),
+		}
+		if extra.CPU > 0 || extra.Memory > 0 {
+			canAllocate, reason, err := s.resourceManager.CanAllocate(ctx, extra)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			if !canAllocate {
+				return nil, errors.NewSystemCapacityError(reason)
+			}
+		}
+	}
+
+	var priceChange *dbservice.PriceChange
+	if updated.Cost.HourlyLemons != instance.Cost.HourlyLemons {
+		if updated.Cost.HourlyLemons > instance.Cost.HourlyLemons {
+			usr, err := s.userStore.FindById(ctx, userID)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			if usr.LemonBalance < updated.Cost.HourlyLemons {
+				return nil, errors.NewInsufficientLemonsError(updated.Cost.HourlyLemons,
+					updated.Cost.HourlyLemons-usr.LemonBalance)
+			}
+		}
+
+		effectiveFrom := time.Now()
+		if instance.LastBilledAt != nil && instance.LastBilledAt.Add(time.Hour).After(effectiveFrom) {
+			effectiveFrom = instance.LastBilledAt.Add(time.Hour)
+		}
+		priceChange = &dbservice.PriceChange{
+			InstanceID:           instance.ID,
+			PreviousSize:         instance.Size,
+			NewSize:              updated.Size,
+			PreviousHourlyLemons: instance.Cost.HourlyLemons,
+			NewHourlyLemons:      updated.Cost.HourlyLemons,
+			EffectiveFrom:        effectiveFrom,
+		}
+	}
+
+	// CRD 가 거부하면 DB 는 그대로 둠
+	if err := s.updateK8sResource(ctx, &updated); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	if err := s.dbiStore.UpdateSpec(ctx, &updated, priceChange); err != nil {
+		if rollbackErr := s.updateK8sResource(ctx, instance); rollbackErr != nil {
+			s.logger.Printf("CRITICAL: 인스턴스 %s 스펙 롤백 실패: %v", instance.ExternalID, rollbackErr)
+		}
+		return nil, errors.Wrap(err)
+	}
+
+	if priceChange != nil {
+		s.logger.Printf("인스턴스 %s 스펙 변경: %s -> %s, 시간당 %d -> %d 레몬 (%s 부터)",
+			instance.ExternalID, priceChange.PreviousSize, priceChange.NewSize,
+			priceChange.PreviousHourlyLemons, priceChange.NewHourlyLemons,
+			priceChange.EffectiveFrom.Format(time.RFC3339))
+	} else {
+		s.logger.Printf("인스턴스 %s 설정 변경", instance.ExternalID)
+	}
+
+	return &updated, nil
+}
+
+func (s *service) DeleteInstance(ctx context.Context, userID, instanceID string, permanent bool) (*job.Job, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil && permanent {
+		// 휴지통에 있는 인스턴스를 바로 영구 삭제
+		if instance, err = s.dbiStore.FindDeleted(ctx, instanceID); err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+
+	if instance.DeletedAt == nil && !instance.CanDelete() {
+		return nil, errors.NewInvalidStatusTransitionError(string(instance.Status), string(dbservice.StatusDeleting))
+	}
 
-					if err := s.dbiStore.UpdateStatus(ctx, instance.ID, k8sStatus, reason); err != nil {
-						s.logger.Printf("Failed to update status in DB: %v", err)
-					} else {
-						instance.Status = k8sStatus
-						instance.StatusReason = reason
-					}
-				}
+	if permanent {
+		// 작업을 먼저 등록, 상태 변경이 실패해도 정리는 진행됨
+		deleteJob, err := s.enqueueDelete(ctx, instance, true)
+		if err != nil {
+			return nil, err
+		}
+		if instance.DeletedAt == nil {
+			if err := s.dbiStore.UpdateStatus(ctx, instance.ID, dbservice.StatusDeleting, "Deletion requested"); err != nil {
+				s.logger.Printf("인스턴스 %s 삭제 상태 변경 실패: %v", instance.ExternalID, err)
 			}
 		}
+		return deleteJob, nil
+	}
 
-		// MongoDB 상태 확인 (provisioning 등)
-		if instance.Status == dbservice.StatusProvisioning {
-			status, err := s.k8sClient.GetMongoDBStatus(ctx, instance.K8sNamespace, instance.K8sResourceName)
-			if err != nil {
-				s.logger.Printf("Failed to get MongoDB status: %v", err)
-			} else {
-				s.logger.Printf("K8s status - Phase: %s, Ready: %v", status.Phase, status.Ready)
-
-				// 상태 업데이트
-				newStatus := s.mapMongoDBStatus(status)
-				s.logger.Printf("Mapped status: %s", newStatus)
-
-				if newStatus != instance.Status {
-					if err := s.dbiStore.UpdateStatus(ctx, instance.ID, newStatus, status.Message); err != nil {
-						s.logger.Printf("Failed to update status in DB: %v", err)
-					} else {
-						instance.Status = newStatus
-						instance.StatusReason = status.Message
-					}
-				}
+	purgeAfter := time.Now().Add(s.deleteRetention)
+	if err := s.dbiStore.SoftDelete(ctx, instance.ID, purgeAfter); err != nil {
+		return nil, errors.Wrap(err)
+	}
 
-				// 연결 정보 업데이트
-				if status.Ready && instance.Endpoint == "" {
-					instance.Endpoint = status.Endpoint
-					instance.Port = int(status.Port)
-					_ = s.dbiStore.Update(ctx, instance)
-				}
-			}
+	deleteJob, err := s.enqueueDelete(ctx, instance, false)
+	if err != nil {
+		// 롤백
+		if undoErr := s.dbiStore.Undelete(ctx, instance); undoErr == nil {
+			_ = s.dbiStore.UpdateStatus(ctx, instance.ID, instance.Status, instance.StatusReason)
 		}
+		return nil, err
 	}
 
-	return instance, nil
+	s.logger.Printf("인스턴스 %s 휴지통 이동, %s 이후 정리", instance.ExternalID, purgeAfter.Format(time.RFC3339))
+	return deleteJob, nil
 }
 
-func (s *service) ListPresets(ctx context.Context) ([]*dbservice.DBPreset, error) {
-	presets, err := s.presetStore.ListByType(ctx, dbservice.MongoDB)
+func (s *service) enqueueDelete(ctx context.Context, instance *dbservice.DBInstance, permanent bool) (*job.Job, error) {
+	deleteJob, err := s.jobQueue.Enqueue(ctx, &job.EnqueueRequest{
+		Type:       dbservice.JobDeleteInstance,
+		UserID:     instance.UserID,
+		ResourceID: instance.ExternalID,
+		Payload:    dbservice.DeleteJobPayload{Permanent: permanent},
+	})
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-
-	return presets, nil
+	return deleteJob, nil
 }
 
-func (s *service) mapMongoDBStatus(status *k8s.MongoDBStatus) dbservice.InstanceStatus {
-	switch status.Phase {
-	case "running":
-		if status.Ready {
-			return dbservice.StatusRunning
+// handleDeleteJob 휴지통 이동이면 StatefulSet 을 0 으로 줄이고(PVC 유지), 영구 삭제면 리소스 정리
+func (s *service) handleDeleteJob(ctx context.Context, j *job.Job) error {
+	var payload dbservice.DeleteJobPayload
+	if len(j.Payload) > 0 {
+		if err := json.Unmarshal(j.Payload, &payload); err != nil {
+			return job.Permanent(fmt.Errorf("invalid delete payload: %w", err))
 		}
-		return dbservice.StatusProvisioning
-	case "failed", "error":
-		return dbservice.StatusError
-	case "pending", "provisioning":
-		return dbservice.StatusProvisioning
-	default:
-		s.logger.Printf("Unknown phase: %s", status.Phase) // 디버깅용
-		return dbservice.StatusProvisioning
 	}
-}
-func (s *service) UpdateInstance(ctx context.Context, userID, instanceID string, req *dbservice.UpdateInstanceRequest) (*dbservice.DBInstance, error) {
-	//TODO implement me
-	panic("implement me")
-}
 
-func (s *service) DeleteInstance(ctx context.Context, userID, instanceID string) error {
-	instance, err := s.dbiStore.Find(ctx, instanceID)
+	// 삭제 요청 시 deleted_at 이 기록되므로 삭제된 인스턴스까지 조회
+	instance, err := s.dbiStore.FindIncludingDeleted(ctx, j.ResourceID)
 	if err != nil {
-		return errors.Wrap(err)
+		return err
 	}
-	if instance == nil || instance.UserID != userID {
-		return errors.NewResourceNotFoundError("instance", instanceID)
+	if instance == nil || instance.PurgedAt != nil {
+		s.logger.Printf("Instance %s already deleted", j.ResourceID)
+		return nil
 	}
 
-	if !instance.CanDelete() {
-		return errors.NewInvalidStatusTransitionError(string(instance.Status), string(dbservice.StatusDeleting))
+	if payload.Permanent {
+		return s.purgeInstance(ctx, instance)
 	}
 
-	if err := s.dbiStore.UpdateStatus(ctx, instance.ID, dbservice.StatusDeleting, "Deletion requested"); err != nil {
-		return errors.Wrap(err)
+	// 작업 실행 전에 복구 후 다시 시작한 경우
+	if instance.Status != dbservice.StatusStopped {
+		return nil
+	}
+	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
+		if err := s.k8sClient.PatchDBInstanceStatus(
+			ctx,
+			instance.K8sNamespace,
+			instance.K8sResourceName,
+			string(dbservice.StatusStopped),
+			"Moved to recycle bin",
+		); err != nil {
+			return errors.Wrapf(err, "failed to scale down instance %s", instance.ExternalID)
+		}
 	}
+	return nil
+}
 
+// purgeInstance 포트 해제, CRD 삭제(오퍼레이터가 PVC 등 나머지 리소스 정리) 후 정리 완료 기록
+func (s *service) purgeInstance(ctx context.Context, instance *dbservice.DBInstance) error {
 	// 포트 해제
-	if s.portStore != nil && instance.ExternalID != "" {
+	if s.portStore != nil {
 		if err := s.portStore.ReleasePort(ctx, instance.ExternalID); err != nil {
-			s.logger.Printf("Failed to release port for instance %s: %v", instanceID, err)
+			s.logger.Printf("Failed to release port for instance %s: %v", instance.ExternalID, err)
 			// 포트 해제 실패는 무시하고 계속 진행 (TODO: reporting)
 		}
 	}
 
-	// K8s DBInstance CRD 삭제 (Operator가 나머지 리소스 정리)
+	// K8s 삭제가 실패하면 재시도, 재시도 횟수를 넘기면 deleting 상태로 남아 dead-letter 에서 확인
 	if instance.K8sNamespace != "" && instance.K8sResourceName != "" {
 		if err := s.k8sClient.DeleteDBInstance(ctx, instance.K8sNamespace, instance.K8sResourceName); err != nil {
-			s.logger.Printf("Failed to delete K8s resources for instance %s: %v", instanceID, err)
-			// K8s 삭제 실패해도 DB는 삭제 처리
-		}
-	}
-
-	if err := s.dbiStore.Delete(ctx, instanceID); err != nil {
-		// 이미 없는 경우는 성공으로 처리
-		if errors.Is(errors.NewResourceNotFoundError("instance", instanceID), err) {
-			s.logger.Printf("Instance %s already deleted", instanceID)
-			return nil
+			return errors.Wrapf(err, "failed to delete K8s resources for instance %s", instance.ExternalID)
 		}
-		return errors.Wrap(err)
 	}
 
-	return nil
+	return s.dbiStore.MarkPurged(ctx, instance.ID)
 }
 
 func (s *service) StartInstance(ctx context.Context, userID, instanceID string) error {
@@ -277,6 +1190,11 @@ func (s *service) StartInstance(ctx context.Context, userID, instanceID string)
 	// 7. 과금 시간 업데이트
 	_ = s.dbiStore.UpdateBillingTime(ctx, instance.ID, time.Now())
 
+	// 8. 유휴 시간은 다시 측정 (중지 전 메트릭으로 바로 다시 중지되지 않도록)
+	if instance.IdleSince != nil {
+		_ = s.dbiStore.UpdateIdleSince(ctx, instance.ID, nil)
+	}
+
 	s.logger.Printf("인스턴스 %s 시작됨", instanceID)
 	return nil
 }
@@ -321,20 +1239,65 @@ func (s *service) StopInstance(ctx context.Context, userID, instanceID string) e
 	return nil
 }
 
-func (s *service) RestartInstance(ctx context.Context, userID, instanceID string) error {
-	if err := s.StopInstance(ctx, userID, instanceID); err != nil {
-		return errors.Wrap(err)
+func (s *service) RestartInstance(ctx context.Context, userID, instanceID string) (*job.Job, error) {
+	instance, err := s.dbiStore.Find(ctx, instanceID)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if instance == nil || instance.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("instance", instanceID)
+	}
+	if !instance.CanStop() {
+		return nil, errors.NewInvalidStatusTransitionError(string(instance.Status), string(dbservice.StatusStopped))
 	}
 
-	time.Sleep(2 * time.Second)
+	restartJob, err := s.jobQueue.Enqueue(ctx, &job.EnqueueRequest{
+		Type:       dbservice.JobRestartInstance,
+		UserID:     userID,
+		ResourceID: instance.ExternalID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return restartJob, nil
+}
 
-	if err := s.StartInstance(ctx, userID, instanceID); err != nil {
-		return errors.Wrap(err)
+// handleRestartJob 중지 후 시작, 재시도 시 이미 중지된 상태면 시작만 진행
+func (s *service) handleRestartJob(ctx context.Context, j *job.Job) error {
+	instance, err := s.dbiStore.Find(ctx, j.ResourceID)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return job.Permanent(errors.NewResourceNotFoundError("instance", j.ResourceID))
 	}
 
+	if instance.Status != dbservice.StatusStopped {
+		if err := s.StopInstance(ctx, j.UserID, j.ResourceID); err != nil {
+			return restartJobError(err)
+		}
+
+		select {
+		case <-time.After(2 * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := s.StartInstance(ctx, j.UserID, j.ResourceID); err != nil {
+		return restartJobError(err)
+	}
 	return nil
 }
 
+// restartJobError 상태 전이/잔액 부족 같은 도메인 에러는 재시도하지 않음
+func restartJobError(err error) error {
+	if retryableProvisionError(err) {
+		return err
+	}
+	return job.Permanent(err)
+}
+
 func (s *service) CreateBackup(ctx context.Context, userID, instanceID string, name string) (*dbservice.BackupRecord, error) {
 	//TODO implement me
 	panic("implement me")
@@ -358,27 +1321,47 @@ func (s *service) InstanceMetrics(ctx context.Context, instanceID string) (*dbse
 var _ dbservice.Service = (*service)(nil)
 
 func NewService(
-	publicDBHost string,
+	access dbservice.ExternalAccess,
 	dbiStore dbservice.DBInstanceStore,
 	presetStore dbservice.PresetStore,
 	lemonService lemon.Service,
 	userStore user.Store,
 	k8sClient k8s.Client,
 	portStore dbservice.PortStore,
+	sagaStore dbservice.ProvisionSagaStore,
+	jobQueue job.Queue,
 	resourceManager resource.Manager,
+	importStager *ImportStager,
+	backupSigner *BackupURLSigner,
+	deleteRetention time.Duration,
 	logger *log.Logger,
 ) dbservice.Service {
-	return &service{
-		publicDBHost:    publicDBHost,
+	if deleteRetention <= 0 {
+		deleteRetention = dbservice.DefaultDeleteRetention
+	}
+
+	s := &service{
+		access:          access,
 		dbiStore:        dbiStore,
 		presetStore:     presetStore,
 		lemonService:    lemonService,
 		userStore:       userStore,
 		k8sClient:       k8sClient,
 		portStore:       portStore,
+		sagaStore:       sagaStore,
+		jobQueue:        jobQueue,
 		resourceManager: resourceManager,
+		importStager:    importStager,
+		backupSigner:    backupSigner,
+		deleteRetention: deleteRetention,
 		logger:          logger,
 	}
+
+	// 오래 걸리는 인스턴스 작업은 작업 큐에서 실행
+	jobQueue.Register(dbservice.JobProvisionInstance, s.handleProvisionJob)
+	jobQueue.Register(dbservice.JobDeleteInstance, s.handleDeleteJob)
+	jobQueue.Register(dbservice.JobRestartInstance, s.handleRestartJob)
+	return s
 }
 
 func (s *service) CreateInstance(ctx context.Context, userID string, userLemon int, req *dbservice.CreateInstanceRequest) (*dbservice.CreateInstanceResponse, error) {
@@ -396,6 +1379,9 @@ func (s *service) CreateInstance(ctx context.Context, userID string, userLemon i
 		return nil, errors.Wrap(err)
 	}
 	if existing != nil {
+		if existing.DeletedAt != nil {
+			return nil, errors.NewInstanceNameInRecycleBinError(req.Name)
+		}
 		return nil, errors.NewInstanceNameConflictError(req.Name)
 	}
 
@@ -406,34 +1392,112 @@ func (s *service) CreateInstance(ctx context.Context, userID string, userLemon i
 		StorageSize:   "",
 	}
 
-	var instance *dbservice.DBInstance
+	instance, sampleDatasetID, provision, err := s.buildInstance(ctx, userID, req, backupCfg)
+	if err != nil {
+		return nil, err
+	}
+	instance.Labels = req.Labels
+	instance.Description = req.Description
+	if err := validateCustomConfig(req, instance); err != nil {
+		return nil, err
+	}
+	if err := s.checkCapacity(ctx, instance.Resources); err != nil {
+		return nil, err
+	}
+
+	if req.SampleDataset != nil {
+		sampleDatasetID = *req.SampleDataset
+	}
+	dataset, err := lookupSampleDataset(instance.Type, sampleDatasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 레몬 잔액 확인
+	if userLemon < instance.Cost.CreationCost {
+		return nil, errors.NewInsufficientLemonsError(instance.Cost.CreationCost+1, instance.Cost.CreationCost-userLemon)
+	}
+
+	// 인스턴스와 생성 사가를 함께 저장, 이후 단계는 사가에 기록하며 진행
+	// 이 요청이 중간에 끊기면 ProvisionWorker 가 이어서 진행하거나 역순으로 보상
+	saga := &dbservice.ProvisionSaga{
+		Status: dbservice.SagaRunning,
+		Options: dbservice.ProvisionSagaOptions{
+			SampleDataset: sampleDatasetID,
+		},
+	}
+	if clone := provision.cloneFrom; clone != nil {
+		saga.Options.CloneInstance = clone.Instance
+		saga.Options.CloneArchive = clone.Archive
+	}
+	if err := s.sagaStore.CreateWithInstance(ctx, instance, saga); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// 차감/포트 할당은 바로 실행해서 잔액 부족 등을 응답으로 알림
+	run := &provisionRun{saga: saga, instance: instance, dataset: dataset, opts: provision}
+	if err := s.runProvisionSteps(ctx, run, dbservice.StepAllocatePort); err != nil {
+		s.compensateProvisioning(ctx, run, err)
+		return nil, err
+	}
+
+	// 접속 정보는 이 응답에서만 제공하므로 Secret 은 요청에서 만들고, 나머지 K8s 작업은 작업 큐에서 진행
+	secretData, err := s.prepareK8sSecret(ctx, instance)
+	if err != nil {
+		s.compensateProvisioning(ctx, run, err)
+		return nil, errors.Wrapf(err, "failed to create instance secret")
+	}
+
+	provisionJob, err := s.jobQueue.Enqueue(ctx, &job.EnqueueRequest{
+		Type:       dbservice.JobProvisionInstance,
+		UserID:     userID,
+		ResourceID: instance.ExternalID,
+		Payload:    dbservice.ProvisionJobPayload{SagaID: saga.ID},
+	})
+	if err != nil {
+		s.compensateProvisioning(ctx, run, err)
+		return nil, errors.Wrap(err)
+	}
+
+	username, password := string(secretData["username"]), string(secretData["password"])
+	resp := instance.ToCreateResponse(s.buildCredentials(instance, username, password))
+	resp.JobID = provisionJob.ID
+	return resp, nil
+}
+
+// buildInstance 프리셋/커스텀/복제 요청에 따라 저장 전 인스턴스 구성
+// 커스텀 설정 검증과 리소스 확인은 호출하는 쪽에서 (견적은 실패해도 구성 결과를 보여줌)
+func (s *service) buildInstance(ctx context.Context, userID string, req *dbservice.CreateInstanceRequest,
+	backupCfg dbservice.BackupConfig) (*dbservice.DBInstance, string, provisionOptions, error) {
+	var provision provisionOptions
+	if req.IsClone() {
+		// 복제: 원본과 같은 타입/모드
+		instance, cloneFrom, err := s.buildCloneInstance(ctx, userID, req, backupCfg)
+		if err != nil {
+			return nil, "", provision, err
+		}
+		provision.cloneFrom = cloneFrom
+		return instance, "", provision, nil
+	}
+
 	if req.PresetID != nil {
 		// 프리셋 기반 생성
 		preset, err := s.presetStore.Find(ctx, *req.PresetID)
 		if err != nil {
-			return nil, errors.Wrap(err)
+			return nil, "", provision, errors.Wrap(err)
 		}
-		if preset == nil {
-			return nil, errors.NewResourceNotFoundError("preset", *req.PresetID)
+		if preset == nil || !preset.VisibleTo(userID) {
+			return nil, "", provision, errors.NewResourceNotFoundError("preset", *req.PresetID)
 		}
 		if !preset.Available {
-			return nil, errors.NewInvalidParameterError("preset", preset.UnavailableReason)
-		}
-
-		// 리소스 체크
-		sysResource := resource.SystemResourceSpec{
-			CPU:    preset.Resources.CPU,
-			Memory: preset.Resources.Memory,
-		}
-		canAllocate, reason, err := s.resourceManager.CanAllocate(ctx, sysResource)
-		if err != nil {
-			return nil, errors.Wrap(err)
+			return nil, "", provision, errors.NewInvalidParameterError("preset", preset.UnavailableReason)
 		}
-		if !canAllocate {
-			return nil, errors.NewSystemCapacityError(reason)
+		// 요청에 백업 설정이 없으면 프리셋에 저장된 백업 정책 사용
+		if !backupCfg.Enabled && preset.Backup.Enabled {
+			backupCfg = preset.Backup
 		}
 
-		instance = &dbservice.DBInstance{
+		return &dbservice.DBInstance{
 			ExternalID:        uuid.New().String(),
 			UserID:            userID,
 			Name:              req.Name,
@@ -448,167 +1512,202 @@ func (s *service) CreateInstance(ctx context.Context, userID string, userLemon i
 			Status:            dbservice.StatusProvisioning,
 			CreatedAt:         time.Now(),
 			UpdatedAt:         time.Now(),
-		}
-	} else {
-		// 커스텀 스펙
-		if req.Type == nil || req.Resources == nil {
-			return nil, errors.NewInvalidParameterError("type,resources", "required")
-		}
-
-		// 리소스 체크
-		sysResource := resource.SystemResourceSpec{
-			CPU:    req.Resources.CPU,
-			Memory: req.Resources.Memory,
-		}
-		canAllocate, reason, err := s.resourceManager.CanAllocate(ctx, sysResource)
-		if err != nil {
-			return nil, errors.Wrap(err)
-		}
-		if !canAllocate {
-			return nil, errors.NewSystemCapacityError(reason)
-		}
-
-		// 모드 기본값 설정
-		mode := *req.Mode
-		if req.Mode == nil {
-			switch *req.Type {
-			case dbservice.MongoDB:
-				mode = dbservice.ModeStandalone
-			case dbservice.Redis:
-				mode = dbservice.ModeBasic
-			}
-		}
+		}, preset.SampleDataset, provision, nil
+	}
 
-		configValidator := dbservice.NewConfigValidator()
+	// 커스텀 스펙
+	if req.Type == nil || req.Resources == nil {
+		return nil, "", provision, errors.NewInvalidParameterError("type,resources", "required")
+	}
 
-		// Config 처리: 기본값 + 사용자 입력
-		finalConfig := configValidator.GetDefaultConfig(*req.Type, mode)
-		if req.Config != nil {
-			for k, v := range req.Config {
-				finalConfig[k] = v
-			}
-		}
+	// 모드 기본값 설정
+	mode := req.Type.DefaultMode()
+	if req.Mode != nil {
+		mode = *req.Mode
+	}
 
-		// Config 검증
-		if err := configValidator.ValidateConfig(*req.Type, mode, finalConfig, req.Resources); err != nil {
-			return nil, errors.NewInvalidParameterError("config", err.Error())
+	// Config 처리: 기본값 + 사용자 입력
+	finalConfig := dbservice.NewConfigValidator().GetDefaultConfig(*req.Type, mode)
+	if req.Config != nil {
+		for k, v := range req.Config {
+			finalConfig[k] = v
 		}
+	}
 
-		// 크기 계산
-		size := req.Resources.CalculateSize()
-		cost := dbservice.CalculateCustomCost(*req.Type, *req.Resources)
+	return &dbservice.DBInstance{
+		ExternalID:   uuid.New().String(),
+		UserID:       userID,
+		Name:         req.Name,
+		Type:         *req.Type,
+		Size:         req.Resources.CalculateSize(),
+		Mode:         mode,
+		Resources:    *req.Resources,
+		Cost:         dbservice.CalculateCustomCost(*req.Type, *req.Resources),
+		Config:       finalConfig,
+		BackupConfig: backupCfg,
+		Status:       dbservice.StatusProvisioning,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}, "", provision, nil
+}
 
-		instance = &dbservice.DBInstance{
-			ExternalID:   uuid.New().String(),
-			UserID:       userID,
-			Name:         req.Name,
-			Type:         *req.Type,
-			Size:         size,
-			Mode:         mode,
-			Resources:    *req.Resources,
-			Cost:         cost,
-			Config:       finalConfig,
-			BackupConfig: backupCfg,
-			Status:       dbservice.StatusProvisioning,
-			CreatedAt:    time.Now(),
-			UpdatedAt:    time.Now(),
-		}
+// validateCustomConfig 커스텀 스펙의 설정 검증, 프리셋과 복제는 이미 검증된 설정 사용
+func validateCustomConfig(req *dbservice.CreateInstanceRequest, instance *dbservice.DBInstance) error {
+	if req.IsClone() || req.PresetID != nil {
+		return nil
 	}
+	if err := dbservice.NewConfigValidator().ValidateConfig(instance.Type, instance.Mode, instance.Config, &instance.Resources); err != nil {
+		return errors.NewInvalidParameterError("config", err.Error())
+	}
+	return nil
+}
 
-	// 레몬 잔액 확인
-	if userLemon < instance.Cost.CreationCost {
-		return nil, errors.NewInsufficientLemonsError(instance.Cost.CreationCost+1, instance.Cost.CreationCost-userLemon)
+func (s *service) checkCapacity(ctx context.Context, resources dbservice.ResourceSpec) error {
+	canAllocate, reason, err := s.resourceManager.CanAllocate(ctx, resource.SystemResourceSpec{
+		CPU:    resources.CPU,
+		Memory: resources.Memory,
+	})
+	if err != nil {
+		return errors.Wrap(err)
 	}
+	if !canAllocate {
+		return errors.NewSystemCapacityError(reason)
+	}
+	return nil
+}
 
-	// 1. 먼저 DB에 인스턴스 저장 (ID 생성됨)
-	if err := s.dbiStore.Create(ctx, instance); err != nil {
-		return nil, errors.Wrap(err)
+// buildCloneInstance 복제 원본을 확인하고 원본과 같은 타입/모드의 인스턴스 구성
+// 데이터는 오퍼레이터가 running 전환 전에 clone Job 으로 채움
+func (s *service) buildCloneInstance(ctx context.Context, userID string, req *dbservice.CreateInstanceRequest,
+	backupCfg dbservice.BackupConfig) (*dbservice.DBInstance, *k8s.CloneSourceSpec, error) {
+	if req.SourceInstanceID != nil && req.SourceBackupID != nil {
+		return nil, nil, errors.NewInvalidParameterError("sourceInstanceId,sourceBackupId", "하나만 지정할 수 있습니다")
+	}
+	if req.PresetID != nil || req.Type != nil || req.Mode != nil {
+		return nil, nil, errors.NewInvalidParameterError("presetId,type,mode", "복제 시에는 원본 설정을 사용합니다")
+	}
+	if req.SampleDataset != nil && *req.SampleDataset != "" {
+		return nil, nil, errors.NewInvalidParameterError("sampleDataset", "복제 시에는 샘플 데이터셋을 적재할 수 없습니다")
 	}
 
-	// 환불 보장을 위한 defer
-	lemonDeducted := false
-	portAllocated := false
-	defer func() {
+	var source *dbservice.DBInstance
+	archive := ""
+	if req.SourceBackupID != nil {
+		backup, err := s.dbiStore.FindBackup(ctx, *req.SourceBackupID)
 		if err != nil {
-			if lemonDeducted {
-				refundErr := s.lemonService.AddLemons(ctx, userID, instance.Cost.CreationCost,
-					lemon.ActionInstanceCreateRefund, fmt.Sprintf("실패: %v", err), &instance.ID)
-				if refundErr != nil {
-					s.logger.Printf("CRITICAL: 환불 실패 - userID: %s, instanceID: %d, amount: %d, error: %v",
-						userID, instance.ID, instance.Cost.CreationCost, refundErr)
-					// TODO: 환불 실패 기록 테이블에 저장
-				}
-			}
-			if portAllocated {
-				_ = s.portStore.ReleasePort(ctx, instance.ExternalID)
+			return nil, nil, errors.Wrap(err)
+		}
+		if backup != nil {
+			if source, err = s.dbiStore.FindByID(ctx, backup.InstanceID); err != nil {
+				return nil, nil, errors.Wrap(err)
 			}
 		}
-	}()
-
-	// 2. 레몬 차감
-	if err := s.lemonService.DeductLemons(ctx, userID, instance.Cost.CreationCost,
-		lemon.ActionInstanceCreate, fmt.Sprintf("인스턴스 %s 생성", instance.Name), &instance.ID); err != nil {
-		_ = s.dbiStore.Delete(ctx, instance.ExternalID)
-		return nil, errors.Wrap(err)
+		if backup == nil || source == nil || source.UserID != userID {
+			return nil, nil, errors.NewResourceNotFoundError("backup", *req.SourceBackupID)
+		}
+		if backup.Status != dbservice.BackupStatusCompleted || backup.StoragePath == "" {
+			return nil, nil, errors.NewInvalidParameterError("sourceBackupId", "완료된 백업만 복원할 수 있습니다")
+		}
+		// 오퍼레이터는 원본 백업 PVC 를 /backup 에 마운트함
+		archive = strings.TrimPrefix(backup.StoragePath, "/backup/")
+	} else {
+		var err error
+		if source, err = s.dbiStore.Find(ctx, *req.SourceInstanceID); err != nil {
+			return nil, nil, errors.Wrap(err)
+		}
+		if source == nil || source.UserID != userID {
+			return nil, nil, errors.NewResourceNotFoundError("instance", *req.SourceInstanceID)
+		}
+		if source.Status != dbservice.StatusRunning {
+			return nil, nil, errors.NewInstanceNotReadyError(string(source.Status))
+		}
+	}
+	if source.K8sNamespace == "" {
+		return nil, nil, errors.NewInstanceNotReadyError(string(source.Status))
 	}
-	lemonDeducted = true
 
-	// 3. 포트 할당 (K8s 리소스 생성 전에!)
-	if s.portStore != nil {
-		port, err := s.portStore.AllocatePort(ctx, instance.ExternalID)
-		if err != nil {
-			s.logger.Printf("WARNING: 외부 포트 할당 실패: %v", err)
-		} else {
-			s.logger.Printf("DEBUG: Port %d allocated successfully", port)
-			instance.ExternalPort = port
-			portAllocated = true
+	engine, ok := dbservice.LookupEngine(source.Type)
+	if !ok {
+		return nil, nil, errors.NewInvalidParameterError("type", fmt.Sprintf("지원하지 않는 DB 타입: %s", source.Type))
+	}
+	cloner, ok := engine.(dbservice.Cloner)
+	if !ok {
+		return nil, nil, errors.NewInvalidParameterError("type", fmt.Sprintf("%s 는 복제를 지원하지 않습니다", source.Type))
+	}
+	if err := cloner.ValidateClone(source, archive != ""); err != nil {
+		return nil, nil, err
+	}
 
-			// DB 업데이트
-			if err := s.dbiStore.Update(ctx, instance); err != nil {
-				s.logger.Printf("Failed to update port info in DB: %v", err)
-			}
+	// 리소스를 바꾸면 커스텀 스펙과 같은 방식으로 비용 계산
+	resources, size, cost := source.Resources, source.Size, source.Cost
+	if req.Resources != nil {
+		if req.Resources.Disk < source.Resources.Disk {
+			return nil, nil, errors.NewInvalidParameterError("resources.disk", "원본보다 작은 디스크로 복제할 수 없습니다")
 		}
+		resources = *req.Resources
+		size = resources.CalculateSize()
+		cost = dbservice.CalculateCustomCost(source.Type, resources)
 	}
 
-	// 4. K8s 리소스 생성 (이제 instance.ExternalPort가 설정된 상태)
-	secretData, err := s.provisionK8sResources(ctx, instance)
-	if err != nil {
-		_ = s.dbiStore.UpdateStatus(ctx, instance.ID, dbservice.StatusError, "K8s provisioning failed")
-		return nil, errors.Wrapf(err, "failed to provision k8s resources")
+	config := make(map[string]interface{}, len(source.Config))
+	for k, v := range source.Config {
+		config[k] = v
 	}
-	username, password := string(secretData["username"]), string(secretData["password"])
 
-	// 5. 외부 접근 설정
+	instance := &dbservice.DBInstance{
+		ExternalID:   uuid.New().String(),
+		UserID:       userID,
+		Name:         req.Name,
+		Type:         source.Type,
+		Size:         size,
+		Mode:         source.Mode,
+		Resources:    resources,
+		Cost:         cost,
+		Config:       config,
+		BackupConfig: backupCfg,
+		Status:       dbservice.StatusProvisioning,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	s.logger.Printf("인스턴스 복제 요청: %s -> %s (backup: %t)", source.ExternalID, instance.ExternalID, archive != "")
+	return instance, &k8s.CloneSourceSpec{Instance: source.Name, Archive: archive}, nil
+}
+
+// buildCredentials 사용자에게 한 번만 보여주는 접속 정보 (외부 URI 포함)
+func (s *service) buildCredentials(instance *dbservice.DBInstance, username, password string) *dbservice.Credentials {
 	credentials := &dbservice.Credentials{
 		Username: username,
 		Password: password,
 	}
 
-	if instance.ExternalPort > 0 {
-		credentials.ExternalHost = s.publicDBHost
-		credentials.ExternalPort = instance.ExternalPort
-		if instance.Type == dbservice.MongoDB {
-			credentials.ExternalURI = fmt.Sprintf("mongodb://%s:%s@%s:%d/%s?authSource=admin",
-				username, password, s.publicDBHost, instance.ExternalPort, req.Name)
-		} else {
-			credentials.ExternalURI = fmt.Sprintf("%s://%s:%s@%s:%d/%s",
-				instance.Type, username, password,
-				s.publicDBHost, instance.ExternalPort, req.Name)
+	if endpoint := s.access.Endpoint(instance.ExternalID, instance.ExternalPort); endpoint != nil {
+		credentials.ExternalHost = endpoint.Host
+		credentials.ExternalPort = endpoint.Port
+		if engine, ok := dbservice.LookupEngine(instance.Type); ok {
+			credentials.ExternalURI = engine.ConnectionURI(username, password,
+				endpoint.Host, endpoint.Port, instance.Name, endpoint.TLS)
 		}
 	}
 
-	return instance.ToCreateResponse(credentials), nil
+	return credentials
 }
 
-func (s *service) provisionK8sResources(ctx context.Context, instance *dbservice.DBInstance) (map[string][]byte, error) {
-	// 네임스페이스 생성
+// provisionOptions 생성 직후 오퍼레이터가 수행할 작업
+type provisionOptions struct {
+	dataImport *k8s.DataImportSpec  // 샘플 데이터셋 적재
+	cloneFrom  *k8s.CloneSourceSpec // 복제 원본, running 전에 데이터를 채움
+}
+
+// prepareK8sSecret 네임스페이스와 루트 계정 Secret 생성
+// 생성 응답에서 접속 정보를 바로 돌려주기 위해 프로비저닝 작업 등록 전에 요청에서 실행
+func (s *service) prepareK8sSecret(ctx context.Context, instance *dbservice.DBInstance) (map[string][]byte, error) {
 	namespace := fmt.Sprintf("user-%s", instance.UserID)
 	if err := s.k8sClient.CreateNamespace(ctx, namespace); err != nil {
 		return nil, err
 	}
 	instance.K8sNamespace = namespace
 
-	// Secret 생성
 	secretName := fmt.Sprintf("%s-secret", instance.Name)
 	secretData := s.generateSecretData(instance)
 	if err := s.k8sClient.CreateSecret(ctx, namespace, secretName, secretData); err != nil {
@@ -616,9 +1715,35 @@ func (s *service) provisionK8sResources(ctx context.Context, instance *dbservice
 	}
 	instance.K8sSecretRef = secretName
 
+	return secretData, nil
+}
+
+// provisionK8sResources 요청에서 만든 Secret 을 그대로 쓰고, 없으면(요청이 중간에 끊긴 경우) 새로 생성
+func (s *service) provisionK8sResources(ctx context.Context, instance *dbservice.DBInstance, opts provisionOptions) error {
+	// 네임스페이스 생성
+	namespace := fmt.Sprintf("user-%s", instance.UserID)
+	if err := s.k8sClient.CreateNamespace(ctx, namespace); err != nil {
+		return err
+	}
+	instance.K8sNamespace = namespace
+
+	// Secret 생성
+	secretName := fmt.Sprintf("%s-secret", instance.Name)
+	existing, err := s.k8sClient.SecretData(ctx, namespace, secretName)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		s.logger.Printf("Secret 이 없어 새로 생성, 접속 정보는 비밀번호 교체로 재발급 필요: %s", instance.ExternalID)
+		if err := s.k8sClient.CreateSecret(ctx, namespace, secretName, s.generateSecretData(instance)); err != nil {
+			return err
+		}
+	}
+	instance.K8sSecretRef = secretName
+
 	// DBInstance CRD 생성
-	if err := s.createDBInstanceCRD(ctx, instance); err != nil {
-		return nil, err
+	if err := s.createDBInstanceCRD(ctx, instance, opts); err != nil {
+		return err
 	}
 
 	if err := s.dbiStore.Update(ctx, instance); err != nil {
@@ -626,10 +1751,10 @@ func (s *service) provisionK8sResources(ctx context.Context, instance *dbservice
 		// 실패해도 계속 진행 (이미 K8s 리소스는 생성됨)
 	}
 
-	return secretData, nil
+	return nil
 }
 
-func (s *service) createDBInstanceCRD(ctx context.Context, instance *dbservice.DBInstance) error {
+func (s *service) createDBInstanceCRD(ctx context.Context, instance *dbservice.DBInstance, opts provisionOptions) error {
 	s.logger.Printf("DEBUG: Creating CRD - instance.ExternalPort: %d", instance.ExternalPort)
 
 	params := k8s.DBInstanceParams{
@@ -653,6 +1778,11 @@ func (s *service) createDBInstanceCRD(ctx context.Context, instance *dbservice.D
 		},
 		Config:       instance.Config,
 		ExternalPort: int32(instance.ExternalPort),
+		ExternalHost: s.access.GatewayHost(instance.ExternalID),
+		AllowedCIDRs: instance.AllowedCIDRs,
+		Profiling:    profilingSpec(instance.Profiling),
+		DataImport:   opts.dataImport,
+		CloneFrom:    opts.cloneFrom,
 	}
 
 	s.logger.Printf("DEBUG: DBInstanceParams.ExternalPort: %d", params.ExternalPort)
@@ -661,11 +1791,10 @@ func (s *service) createDBInstanceCRD(ctx context.Context, instance *dbservice.D
 	spec := k8s.BuildDBInstanceSpec(params)
 	s.logger.Printf("DEBUG: spec map: %+v", spec)
 
-	labels := map[string]string{
-		"app.kubernetes.io/managed-by": "dbtree",
-		"dbtree.cloud/user-id":         instance.UserID,
-		"dbtree.cloud/instance-id":     instance.ExternalID,
-	}
+	labels := k8s.UserLabels(instance.Labels)
+	labels["app.kubernetes.io/managed-by"] = "dbtree"
+	labels["dbtree.cloud/user-id"] = instance.UserID
+	labels["dbtree.cloud/instance-id"] = instance.ExternalID
 
 	dbInstanceCRD := k8s.BuildDBInstanceCRD(instance.K8sNamespace, instance.Name, spec, labels)
 
@@ -695,38 +1824,40 @@ func (s *service) createDBInstanceCRD(ctx context.Context, instance *dbservice.D
 	return nil
 }
 
+// updateK8sResource 인스턴스의 리소스/크기/설정/백업 설정을 CRD spec 에 반영
 func (s *service) updateK8sResource(ctx context.Context, instance *dbservice.DBInstance) error {
-	// DBInstance CRD 업데이트
 	gvr := schema.GroupVersionResource{
 		Group:    "dbtree.cloud",
 		Version:  "v1",
 		Resource: "dbinstances",
 	}
 
-	// 기존 리소스 가져오기
 	existing, err := s.k8sClient.Dynamic().Resource(gvr).Namespace(instance.K8sNamespace).
 		Get(ctx, instance.K8sResourceName, metav1.GetOptions{})
 	if err != nil {
 		return errors.Wrapf(err, "failed to get existing DBInstance")
 	}
 
-	// 스펙 업데이트
-	spec := existing.Object["spec"].(map[string]interface{})
-	spec["resources"] = map[string]interface{}{
-		"cpu":    k8s.ConvertCPUToString(instance.Resources.CPU),
-		"memory": instance.Resources.Memory,
-		"disk":   instance.Resources.Disk,
+	spec, ok := existing.Object["spec"].(map[string]interface{})
+	if !ok {
+		return errors.Wrapf(fmt.Errorf("spec 없음"), "invalid DBInstance %s", instance.K8sResourceName)
 	}
-	spec["backup"] = map[string]interface{}{
-		"enabled":       instance.BackupConfig.Enabled,
-		"schedule":      instance.BackupConfig.Schedule,
-		"retentionDays": instance.BackupConfig.RetentionDays,
+	spec["size"] = string(instance.Size)
+	spec["resources"] = k8s.ResourceSpec{
+		CPU:    instance.Resources.CPU,
+		Memory: instance.Resources.Memory,
+		Disk:   instance.Resources.Disk,
+	}.ToSpec()
+	spec["backup"] = k8s.BackupSpec{
+		Enabled:       instance.BackupConfig.Enabled,
+		Schedule:      instance.BackupConfig.Schedule,
+		RetentionDays: instance.BackupConfig.RetentionDays,
+	}.ToSpec()
+	if instance.Config != nil {
+		spec["config"] = instance.Config
 	}
 
-	_, err = s.k8sClient.Dynamic().Resource(gvr).Namespace(instance.K8sNamespace).
-		Update(ctx, existing, metav1.UpdateOptions{})
-
-	return err
+	return s.k8sClient.UpdateDBInstance(ctx, instance.K8sNamespace, instance.K8sResourceName, existing)
 }
 
 func (s *service) deleteK8sResource(ctx context.Context, instance *dbservice.DBInstance) error {
@@ -778,23 +1909,11 @@ func (s *service) cleanupK8sResources(ctx context.Context, instance *dbservice.D
 func (s *service) generateSecretData(instance *dbservice.DBInstance) map[string][]byte {
 	password, _ := crypto.GenerateSecurePassword()
 
-	switch instance.Type {
-	case dbservice.MongoDB:
-		return map[string][]byte{
-			"username":                   []byte("admin"),
-			"password":                   []byte(password),
-			"MONGO_INITDB_ROOT_USERNAME": []byte("admin"),
-			"MONGO_INITDB_ROOT_PASSWORD": []byte(password),
-			"MONGO_INITDB_DATABASE":      []byte("admin"),
-		}
-	case dbservice.Redis:
-		return map[string][]byte{
-			"password": []byte(password),
-		}
-	default:
-		return map[string][]byte{
-			"username": []byte("admin"),
-			"password": []byte(password),
-		}
+	if engine, ok := dbservice.LookupEngine(instance.Type); ok {
+		return engine.SecretData(rootUsername, password)
+	}
+	return map[string][]byte{
+		"username": []byte(rootUsername),
+		"password": []byte(password),
 	}
 }
@@ -0,0 +1,161 @@
+package dbservice
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/platform/k8s"
+)
+
+// StatusSyncer 오퍼레이터가 갱신한 DBInstance status 를 informer 로 받아 db_instances 에 반영
+// 조회 시점이 아니라 변경 시점에 반영되므로 과금 스케줄러도 최신 상태를 기준으로 동작함
+type StatusSyncer struct {
+	k8sClient    k8s.Client
+	dbiStore     dbservice.DBInstanceStore
+	importStager *ImportStager
+	logger       *log.Logger
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+}
+
+func NewStatusSyncer(k8sClient k8s.Client, dbiStore dbservice.DBInstanceStore, importStager *ImportStager, logger *log.Logger) *StatusSyncer {
+	return &StatusSyncer{
+		k8sClient:    k8sClient,
+		dbiStore:     dbiStore,
+		importStager: importStager,
+		logger:       logger,
+	}
+}
+
+func (s *StatusSyncer) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cancel != nil {
+		s.logger.Println("상태 동기화가 이미 실행 중입니다")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := s.k8sClient.WatchDBInstances(ctx, s.handle); err != nil {
+		cancel()
+		return err
+	}
+
+	s.cancel = cancel
+	s.logger.Println("상태 동기화가 시작되었습니다")
+	return nil
+}
+
+func (s *StatusSyncer) Stop() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cancel == nil {
+		return nil
+	}
+
+	s.cancel()
+	s.cancel = nil
+	s.logger.Println("상태 동기화가 중지되었습니다")
+	return nil
+}
+
+func (s *StatusSyncer) IsRunning() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cancel != nil
+}
+
+func (s *StatusSyncer) handle(status *k8s.DBInstanceStatus) {
+	// 오퍼레이터가 아직 reconcile 하지 않은 리소스
+	if status.ExternalID == "" || status.State == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.dbiStore.SyncK8sStatus(ctx, status.ExternalID, toK8sStatus(status)); err != nil {
+		s.logger.Printf("상태 동기화 실패 (%s/%s): %v", status.Namespace, status.Name, err)
+	}
+
+	// 이미 완료된 교체는 store 에서 무시됨
+	if status.CredentialsRotation != "" {
+		if err := s.dbiStore.CompleteCredentialRotation(ctx, status.CredentialsRotation, status.CredentialsRotatedAt); err != nil {
+			s.logger.Printf("비밀번호 교체 이력 갱신 실패 (%s/%s): %v", status.Namespace, status.Name, err)
+		}
+	}
+
+	if status.DataImport != nil {
+		s.syncDataImport(ctx, status.DataImport)
+	}
+}
+
+// syncDataImport import Job 진행 상태 반영, 끝나면 임시 보관 파일 삭제
+func (s *StatusSyncer) syncDataImport(ctx context.Context, dataImport *k8s.DataImportStatus) {
+	importStatus := dbservice.ImportStatusRunning
+	switch dataImport.Phase {
+	case "Succeeded":
+		importStatus = dbservice.ImportStatusCompleted
+	case "Failed":
+		importStatus = dbservice.ImportStatusFailed
+	}
+
+	// 이미 끝난 요청은 store 에서 무시됨
+	if err := s.dbiStore.UpdateImportStatus(ctx, dataImport.ID, importStatus, dataImport.Message); err != nil {
+		s.logger.Printf("가져오기 이력 갱신 실패 (%s): %v", dataImport.ID, err)
+		return
+	}
+
+	if importStatus.Finished() {
+		if err := s.importStager.Remove(dataImport.ID); err != nil {
+			s.logger.Printf("가져오기 임시 파일 삭제 실패 (%s): %v", dataImport.ID, err)
+		}
+	}
+}
+
+func toK8sStatus(status *k8s.DBInstanceStatus) *dbservice.K8sStatus {
+	result := &dbservice.K8sStatus{
+		Status:       dbservice.InstanceStatus(status.State),
+		StatusReason: status.StatusReason,
+		Endpoint:     status.Endpoint,
+		Port:         int(status.Port),
+		Conditions:   make([]dbservice.K8sCondition, 0, len(status.Conditions)),
+	}
+
+	for _, cond := range status.Conditions {
+		result.Conditions = append(result.Conditions, dbservice.K8sCondition{
+			Type:               cond.Type,
+			Status:             cond.Status,
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: cond.LastTransitionTime,
+		})
+	}
+
+	if status.Metrics != nil {
+		instanceID, _ := uuid.Parse(status.ExternalID)
+		timestamp := time.Now()
+		if status.Metrics.UpdatedAt != nil {
+			timestamp = *status.Metrics.UpdatedAt
+		}
+
+		result.Metrics = &dbservice.InstanceMetrics{
+			InstanceID:          instanceID,
+			CPUUsage:            status.Metrics.CPUUsage,
+			MemoryUsage:         status.Metrics.MemoryUsage,
+			DiskUsage:           status.Metrics.DiskUsage,
+			Connections:         status.Metrics.Connections,
+			OperationsPerSecond: status.Metrics.OperationsPerSecond,
+			Timestamp:           timestamp,
+		}
+	}
+
+	return result
+}
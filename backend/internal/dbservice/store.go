@@ -17,3 +17,7 @@ func NewPresetStore(_ bool, db *sql.DB) dbservice.PresetStore {
 func NewPortStore(_ bool, db *sql.DB) dbservice.PortStore {
 	return postgres.NewPortStore(db)
 }
+
+func NewProvisionSagaStore(_ bool, db *sql.DB) dbservice.ProvisionSagaStore {
+	return postgres.NewProvisionSagaStore(db)
+}
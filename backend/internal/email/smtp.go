@@ -10,6 +10,7 @@ import (
 	"github.com/piper-hyowon/dBtree/internal/core/email"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
 	"github.com/piper-hyowon/dBtree/internal/core/lemon"
+	"html"
 	"math/rand"
 	"net/smtp"
 	"strings"
@@ -287,6 +288,47 @@ func (s *service) SendGoodbye(ctx context.Context, to string) error {
 	return s.SendWithImages(ctx, to, subject, htmlBody, images)
 }
 
+func (s *service) SendIdlePaused(ctx context.Context, to string, instanceName string, idleHours int, resumeURL string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	subject := fmt.Sprintf("[dBtree] %s 인스턴스가 자동으로 중지되었습니다", instanceName)
+
+	htmlTemplate := `
+    <!DOCTYPE html>
+    <html>
+    <head>
+        <meta charset="UTF-8">
+        <title>인스턴스 자동 중지 안내</title>
+    </head>
+    <body style="font-family: Arial, sans-serif; line-height: 1.6; margin: 0; padding: 0;">
+        <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+            <div style="background-color: #4a86e8; color: white; padding: 10px; text-align: center;">
+                <h1 style="margin: 0; padding: 0;">인스턴스 자동 중지 안내</h1>
+            </div>
+            <div style="padding: 20px;">
+                <p>안녕하세요, dBtree입니다.</p>
+                <p><strong>%s</strong> 인스턴스에 %d시간 동안 연결이나 작업이 없어 자동으로 중지했습니다.</p>
+                <p>중지된 동안에는 레몬이 차감되지 않으며, 데이터는 그대로 유지됩니다.</p>
+                <div style="text-align: center; margin: 30px 0;">
+                    <a href="%s" style="background-color: #4a86e8; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px;">인스턴스 다시 시작</a>
+                </div>
+                <p style="font-size: 13px; color: #666;">다시 시작하면 바로 1시간 사용료가 차감됩니다.</p>
+            </div>
+            <div style="font-size: 12px; color: #666; text-align: center; margin-top: 20px;">
+                <p>본 이메일은 자동으로 발송되었습니다. 회신하지 마세요.</p>
+                <p>&copy; 2025 dBtree. All rights reserved.</p>
+            </div>
+        </div>
+    </body>
+    </html>
+    `
+
+	htmlBody := fmt.Sprintf(htmlTemplate, html.EscapeString(instanceName), idleHours, html.EscapeString(resumeURL))
+	return s.Send(ctx, to, subject, htmlBody)
+}
+
 func (s *service) Send(ctx context.Context, to string, subject string, htmlBody string) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
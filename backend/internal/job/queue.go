@@ -0,0 +1,285 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
+)
+
+// Queue Postgres 기반 작업 큐
+// 워커 수만큼 동시에 실행하고, pollInterval 마다(또는 Enqueue 직후) 실행할 작업을 가져옴
+type Queue struct {
+	store        job.Store
+	logger       *log.Logger
+	workers      int
+	pollInterval time.Duration
+
+	handlers   map[job.Type]job.Handler
+	handlersMu sync.RWMutex
+	wake       chan struct{}
+	slots      chan struct{}
+	inflight   sync.WaitGroup
+
+	mutex     sync.Mutex
+	isRunning bool
+	stopPoll  context.CancelFunc
+	pollDone  chan struct{}
+	jobCtx    context.Context
+	cancelJob context.CancelFunc
+}
+
+var _ job.Queue = (*Queue)(nil)
+
+func NewQueue(store job.Store, logger *log.Logger, workers int, pollInterval time.Duration) *Queue {
+	if workers <= 0 {
+		workers = 4
+	}
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+
+	return &Queue{
+		store:        store,
+		logger:       logger,
+		workers:      workers,
+		pollInterval: pollInterval,
+		handlers:     make(map[job.Type]job.Handler),
+		wake:         make(chan struct{}, 1),
+		slots:        make(chan struct{}, workers),
+	}
+}
+
+// Register Start 전에 호출
+func (q *Queue) Register(jobType job.Type, handler job.Handler) {
+	q.handlersMu.Lock()
+	defer q.handlersMu.Unlock()
+	q.handlers[jobType] = handler
+}
+
+func (q *Queue) Enqueue(ctx context.Context, req *job.EnqueueRequest) (*job.Job, error) {
+	payload, err := json.Marshal(req.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal job payload")
+	}
+
+	maxAttempts := req.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = job.DefaultMaxAttempts
+	}
+
+	j := &job.Job{
+		Type:        req.Type,
+		UserID:      req.UserID,
+		ResourceID:  req.ResourceID,
+		Payload:     payload,
+		MaxAttempts: maxAttempts,
+		RunAt:       time.Now(),
+	}
+	if err := q.store.Create(ctx, j); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// 다음 폴링을 기다리지 않고 바로 실행
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return j, nil
+}
+
+func (q *Queue) Find(ctx context.Context, userID, id string) (*job.Job, error) {
+	j, err := q.store.Find(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if j == nil || j.UserID != userID {
+		return nil, errors.NewResourceNotFoundError("job", id)
+	}
+	return j, nil
+}
+
+func (q *Queue) Start() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.isRunning {
+		q.logger.Println("작업 큐가 이미 실행 중입니다")
+		return nil
+	}
+
+	pollCtx, stopPoll := context.WithCancel(context.Background())
+	q.jobCtx, q.cancelJob = context.WithCancel(context.Background())
+	q.stopPoll = stopPoll
+	q.pollDone = make(chan struct{})
+	q.isRunning = true
+
+	go q.poll(pollCtx)
+	q.logger.Printf("작업 큐가 시작되었습니다 (workers: %d)", q.workers)
+	return nil
+}
+
+func (q *Queue) Stop() error {
+	return q.Drain(30 * time.Second)
+}
+
+// Drain 새 작업을 가져오지 않고 실행 중인 작업이 끝나길 기다림
+// timeout 이 지나면 남은 작업을 취소하고, 취소된 작업은 바로 다시 실행되도록 pending 으로 되돌림
+func (q *Queue) Drain(timeout time.Duration) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if !q.isRunning {
+		return nil
+	}
+
+	q.stopPoll()
+	<-q.pollDone
+	q.isRunning = false
+
+	done := make(chan struct{})
+	go func() {
+		q.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancelJob()
+		q.logger.Println("작업 큐가 중지되었습니다")
+		return nil
+	case <-time.After(timeout):
+	}
+
+	q.cancelJob()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+	}
+	return fmt.Errorf("작업 큐 종료 대기 시간 초과 (%s)", timeout)
+}
+
+func (q *Queue) IsRunning() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.isRunning
+}
+
+func (q *Queue) poll(ctx context.Context) {
+	defer close(q.pollDone)
+
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// dispatch 빈 워커 수만큼 작업을 가져와 실행
+func (q *Queue) dispatch(ctx context.Context) {
+	free := cap(q.slots) - len(q.slots)
+	if free == 0 || ctx.Err() != nil {
+		return
+	}
+
+	jobs, err := q.store.ClaimDue(ctx, free, job.Lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			q.logger.Printf("작업 가져오기 실패: %v", err)
+		}
+		return
+	}
+
+	for _, j := range jobs {
+		q.slots <- struct{}{}
+		q.inflight.Add(1)
+		go func(j *job.Job) {
+			defer func() {
+				<-q.slots
+				q.inflight.Done()
+			}()
+			q.execute(j)
+		}(j)
+	}
+}
+
+func (q *Queue) execute(j *job.Job) {
+	// 결과 기록은 종료 중에도 남겨야 하므로 작업 ctx 와 분리
+	storeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	q.handlersMu.RLock()
+	handler, ok := q.handlers[j.Type]
+	q.handlersMu.RUnlock()
+	if !ok {
+		q.finish(storeCtx, j, job.StatusDead, fmt.Sprintf("등록되지 않은 작업 종류: %s", j.Type))
+		return
+	}
+
+	// 점유 만료로 다시 가져온 작업이 이미 횟수를 넘긴 경우 (실행 중 서버가 반복해서 죽음)
+	if j.Attempts > j.MaxAttempts {
+		q.finish(storeCtx, j, job.StatusDead, fmt.Sprintf("재시도 횟수 초과: %s", j.LastError))
+		return
+	}
+
+	ctx, cancelJob := context.WithTimeout(q.jobCtx, job.Lease)
+	defer cancelJob()
+
+	err := q.run(ctx, handler, j)
+	switch {
+	case err == nil:
+		if err := q.store.Complete(storeCtx, j.ID); err != nil {
+			q.logger.Printf("작업 완료 기록 실패 (%s): %v", j.ID, err)
+		}
+	case q.jobCtx.Err() != nil:
+		// 종료로 취소됨, 다음 서버가 바로 다시 실행
+		if err := q.store.Retry(storeCtx, j.ID, time.Now(), "interrupted by shutdown"); err != nil {
+			q.logger.Printf("작업 되돌리기 실패 (%s): %v", j.ID, err)
+		}
+	case job.IsPermanent(err):
+		q.finish(storeCtx, j, job.StatusFailed, err.Error())
+	case j.LastAttempt():
+		q.finish(storeCtx, j, job.StatusDead, err.Error())
+	default:
+		delay := job.RetryDelay(j.Attempts)
+		q.logger.Printf("작업 실패, %s 후 재시도 (%s %s, attempt %d/%d): %v",
+			delay, j.Type, j.ID, j.Attempts, j.MaxAttempts, err)
+		if err := q.store.Retry(storeCtx, j.ID, time.Now().Add(delay), err.Error()); err != nil {
+			q.logger.Printf("작업 재시도 기록 실패 (%s): %v", j.ID, err)
+		}
+	}
+}
+
+// run 핸들러 panic 은 실패로 처리
+func (q *Queue) run(ctx context.Context, handler job.Handler, j *job.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, j)
+}
+
+func (q *Queue) finish(ctx context.Context, j *job.Job, status job.Status, errMsg string) {
+	if status == job.StatusDead {
+		q.logger.Printf("CRITICAL: 작업 dead-letter 처리 (%s %s, resource: %s): %s", j.Type, j.ID, j.ResourceID, errMsg)
+	} else {
+		q.logger.Printf("작업 실패 (%s %s): %s", j.Type, j.ID, errMsg)
+	}
+	if err := q.store.Fail(ctx, j.ID, status, errMsg); err != nil {
+		q.logger.Printf("작업 실패 기록 실패 (%s): %v", j.ID, err)
+	}
+}
@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
+	"github.com/piper-hyowon/dBtree/internal/platform/rest"
+	"github.com/piper-hyowon/dBtree/internal/platform/rest/router"
+)
+
+type Handler struct {
+	queue  job.Queue
+	logger *log.Logger
+}
+
+func NewHandler(queue job.Queue, logger *log.Logger) *Handler {
+	return &Handler{
+		queue:  queue,
+		logger: logger,
+	}
+}
+
+func (h *Handler) GetJob(w http.ResponseWriter, r *http.Request) {
+	user, err := rest.GetUserFromContext(r.Context())
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	id := router.Param(r, "id")
+	if id == "" {
+		rest.HandleError(w, errors.NewMissingParameterError("id"), h.logger)
+		return
+	}
+
+	j, err := h.queue.Find(r.Context(), user.ID, id)
+	if err != nil {
+		rest.HandleError(w, err, h.logger)
+		return
+	}
+
+	rest.SendSuccessResponse(w, http.StatusOK, j.ToResponse())
+}
@@ -0,0 +1,11 @@
+package job
+
+import (
+	"database/sql"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
+	"github.com/piper-hyowon/dBtree/internal/platform/store/postgres"
+)
+
+func NewJobStore(_ bool, db *sql.DB) job.Store {
+	return postgres.NewJobStore(db)
+}
@@ -210,6 +210,55 @@ func (s *service) DeductLemons(ctx context.Context, userID string, amount int, a
 	return s.store.CreateTransaction(ctx, tx)
 }
 
+func (s *service) HasInstanceTransaction(ctx context.Context, instanceID int64, actionType lemon.ActionType) (bool, error) {
+	return s.store.InstanceTransactionExists(ctx, instanceID, actionType)
+}
+
+func (s *service) RecordRefundFailure(ctx context.Context, failure *lemon.RefundFailure) error {
+	return s.store.CreateRefundFailure(ctx, failure)
+}
+
+// RetryRefundFailures 실패한 환불 재시도
+// 인스턴스 환불은 이미 환불 트랜잭션이 있으면(기록 후 재시도가 성공한 경우 등) 다시 지급하지 않음
+func (s *service) RetryRefundFailures(ctx context.Context, limit int) (int, error) {
+	failures, err := s.store.UnresolvedRefundFailures(ctx, limit)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+
+	refunded := 0
+	for _, f := range failures {
+		if f.InstanceID != nil {
+			exists, err := s.store.InstanceTransactionExists(ctx, *f.InstanceID, lemon.ActionInstanceCreateRefund)
+			if err != nil {
+				return refunded, errors.Wrap(err)
+			}
+			if exists {
+				if err := s.store.ResolveRefundFailure(ctx, f.ID); err != nil {
+					return refunded, errors.Wrap(err)
+				}
+				continue
+			}
+		}
+
+		if err := s.AddLemons(ctx, f.UserID, f.Amount, lemon.ActionInstanceCreateRefund,
+			fmt.Sprintf("환불 재시도: %s", f.Reason), f.InstanceID); err != nil {
+			s.logger.Printf("환불 재시도 실패 - id: %d, userID: %s, amount: %d, error: %v", f.ID, f.UserID, f.Amount, err)
+			if err := s.store.IncrementRefundRetry(ctx, f.ID, err.Error()); err != nil {
+				s.logger.Printf("환불 재시도 횟수 기록 실패 (%d): %v", f.ID, err)
+			}
+			continue
+		}
+
+		if err := s.store.ResolveRefundFailure(ctx, f.ID); err != nil {
+			return refunded, errors.Wrap(err)
+		}
+		refunded++
+	}
+
+	return refunded, nil
+}
+
 func (s *service) ValidateInstanceCreation(ctx context.Context, userID string, cost dbservice.LemonCost) error {
 	// 사용자 잔액 조회
 	balance, err := s.store.UserBalance(ctx, userID)
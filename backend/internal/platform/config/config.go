@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -19,7 +21,11 @@ type Config struct {
 	UseLocalMemoryStore bool
 	Redis               RedisConfig
 	K8s                 K8sConfig
+	Import              ImportConfig
+	BackupDownload      BackupDownloadConfig
+	RecycleBin          RecycleBinConfig
 	AdminEmail          string
+	DashboardBaseURL    string // 알림 메일 링크에 쓰는 프론트엔드 주소
 }
 
 type CORSConfig struct {
@@ -29,6 +35,10 @@ type CORSConfig struct {
 
 type ServerConfig struct {
 	PublicDBHost        string
+	DBGatewayDomain     string // 설정 시 SNI 게이트웨이로 외부 접속
+	DBGatewayPort       int
+	DBNodePortEnabled   bool
+	TrustedProxies      []*net.IPNet // X-Forwarded-For 를 믿을 프록시(ingress) 대역
 	Port                int
 	ReadTimeoutSeconds  int
 	WriteTimeoutSeconds int
@@ -75,6 +85,21 @@ type K8sConfig struct {
 	KubeConfigPath string // 로컬 개발시 kubeconfig 경로
 }
 
+type ImportConfig struct {
+	StagingDir      string // 업로드 파일 임시 보관 경로
+	InternalBaseURL string // import Job 이 파일을 내려받을 백엔드 주소 (클러스터 내부)
+}
+
+type BackupDownloadConfig struct {
+	SigningKey    string        // 다운로드 주소 HMAC 키, 비어 있으면 기동 시 임시 키 생성
+	URLTTL        time.Duration // 발급한 주소 유효 시간
+	PublicBaseURL string        // 다운로드 주소 앞에 붙일 공개 API 주소, 비어 있으면 상대 경로
+}
+
+type RecycleBinConfig struct {
+	Retention time.Duration // 삭제한 인스턴스를 복구할 수 있는 기간, 이후 리퍼가 PVC 까지 정리
+}
+
 func NewConfig() (*Config, error) {
 	debugLogging := getEnvString("DEBUG_LOGGING", "false") == "true"
 	useLocalMemoryStore := getEnvString("USE_LOCAL_MEMORY_STORE", "true") == "true"
@@ -84,6 +109,26 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	dbGatewayDomain := getEnvString("DB_GATEWAY_DOMAIN", "")
+	dbGatewayPort, err := getEnvInt("DB_GATEWAY_PORT", 443)
+	if err != nil {
+		return nil, err
+	}
+	// 게이트웨이를 쓰면 NodePort 는 기본 비활성
+	dbNodePortEnabled := getEnvString("DB_NODEPORT_ENABLED", strconv.FormatBool(dbGatewayDomain == "")) == "true"
+
+	var trustedProxies []*net.IPNet
+	for _, cidr := range strings.Split(getEnvString("TRUSTED_PROXY_CIDRS", ""), ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_PROXY_CIDRS 환경 변수 확인: %w", err)
+		}
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+
 	readTimeout, err := getEnvInt("SERVER_READ_TIMEOUT", 10)
 	if err != nil {
 		return nil, err
@@ -171,6 +216,29 @@ func NewConfig() (*Config, error) {
 	k8sInCluster := getEnvString("K8S_IN_CLUSTER", "false") == "true"
 	k8sConfigPath := getEnvString("KUBECONFIG", "")
 
+	importStagingDir := getEnvString("IMPORT_STAGING_DIR", filepath.Join(os.TempDir(), "dbtree-imports"))
+	internalBaseURL := getEnvString("INTERNAL_BASE_URL", "http://backend.default.svc.cluster.local:8080")
+
+	backupDownloadTTL, err := getEnvInt("BACKUP_DOWNLOAD_URL_TTL_SECONDS", 300)
+	if err != nil {
+		return nil, err
+	}
+	if backupDownloadTTL <= 0 {
+		return nil, fmt.Errorf("BACKUP_DOWNLOAD_URL_TTL_SECONDS 는 0보다 커야 함")
+	}
+	backupDownloadSigningKey := getEnvString("BACKUP_DOWNLOAD_SIGNING_KEY", "")
+	publicAPIBaseURL := getEnvString("PUBLIC_API_BASE_URL", "")
+
+	deleteRetentionHours, err := getEnvInt("DELETE_RETENTION_HOURS", 24)
+	if err != nil {
+		return nil, err
+	}
+	if deleteRetentionHours <= 0 {
+		return nil, fmt.Errorf("DELETE_RETENTION_HOURS 는 0보다 커야 함")
+	}
+
+	dashboardBaseURL := strings.TrimRight(getEnvString("DASHBOARD_BASE_URL", corsAllowedOrigins[0]), "/")
+
 	adminEmail := getEnvString("ADMIN_EMAIL", "")
 	if adminEmail == "" {
 		return nil, fmt.Errorf("ADMIN_EMAIL 환경변수 확인")
@@ -179,6 +247,10 @@ func NewConfig() (*Config, error) {
 	return &Config{
 		Server: ServerConfig{
 			PublicDBHost:        publicDBHost,
+			DBGatewayDomain:     dbGatewayDomain,
+			DBGatewayPort:       dbGatewayPort,
+			DBNodePortEnabled:   dbNodePortEnabled,
+			TrustedProxies:      trustedProxies,
 			Port:                port,
 			ReadTimeoutSeconds:  readTimeout,
 			WriteTimeoutSeconds: writeTimeout,
@@ -224,7 +296,20 @@ func NewConfig() (*Config, error) {
 			InCluster:      k8sInCluster,
 			KubeConfigPath: k8sConfigPath,
 		},
-		AdminEmail: adminEmail,
+		Import: ImportConfig{
+			StagingDir:      importStagingDir,
+			InternalBaseURL: internalBaseURL,
+		},
+		BackupDownload: BackupDownloadConfig{
+			SigningKey:    backupDownloadSigningKey,
+			URLTTL:        time.Duration(backupDownloadTTL) * time.Second,
+			PublicBaseURL: publicAPIBaseURL,
+		},
+		RecycleBin: RecycleBinConfig{
+			Retention: time.Duration(deleteRetentionHours) * time.Hour,
+		},
+		AdminEmail:       adminEmail,
+		DashboardBaseURL: dashboardBaseURL,
 	}, nil
 }
 
@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SourceRangeStatus 오퍼레이터가 실제로 적용한 허용 CIDR 목록
+type SourceRangeStatus struct {
+	Applied   []string
+	AppliedAt *time.Time
+}
+
+// SetAllowedSourceRanges spec.allowedSourceRanges 교체, 빈 목록이면 제한 해제
+func (c *client) SetAllowedSourceRanges(ctx context.Context, namespace, name string, ranges []string) error {
+	if ranges == nil {
+		ranges = []string{}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"allowedSourceRanges": ranges,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "allowlist 패치 생성 실패")
+	}
+
+	_, err = c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch DBInstance allowedSourceRanges")
+	}
+
+	return nil
+}
+
+func (c *client) SourceRangeStatus(ctx context.Context, namespace, name string) (*SourceRangeStatus, error) {
+	resource, err := c.DBInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "DBInstance 리소스 조회 실패")
+	}
+	if resource == nil {
+		return nil, errors.NewResourceNotFoundError("DBInstance", namespace+"/"+name)
+	}
+
+	return parseSourceRangeStatus(resource), nil
+}
+
+func parseSourceRangeStatus(resource *unstructured.Unstructured) *SourceRangeStatus {
+	result := &SourceRangeStatus{Applied: []string{}}
+
+	if applied, found, err := unstructured.NestedStringSlice(resource.Object, "status", "appliedSourceRanges"); err == nil && found {
+		result.Applied = applied
+	}
+
+	appliedAt, _, _ := unstructured.NestedFieldNoCopy(resource.Object, "status", "sourceRangesAppliedAt")
+	if t := parseTime(appliedAt); !t.IsZero() {
+		result.AppliedAt = &t
+	}
+
+	return result
+}
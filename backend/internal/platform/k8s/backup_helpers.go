@@ -0,0 +1,224 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	backupReaderImage = "busybox:1.36"
+
+	// backupReaderDeadline 다운로드 한 건이 헬퍼 파드를 붙잡아 둘 수 있는 최대 시간
+	backupReaderDeadline = time.Hour
+	backupReaderStartup  = 2 * time.Minute
+)
+
+// BackupPVCName 오퍼레이터가 만드는 인스턴스 백업 PVC 이름
+func BackupPVCName(instanceName string) string {
+	return instanceName + "-backup-pvc"
+}
+
+// OpenBackupArchive 백업 PVC 를 읽기 전용으로 마운트한 헬퍼 파드에서 exec 로 아카이브를 스트리밍
+// 먼저 파일 크기를 확인해 반환하고, 스트림이 그 크기만큼 오지 않으면 Read 가 에러를 반환
+func (c *client) OpenBackupArchive(ctx context.Context, namespace, pvcName, archive string) (io.ReadCloser, int64, error) {
+	deadline := int64((backupReaderDeadline + imagePullAllowance).Seconds())
+	automount := false
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "backup-reader-",
+			Namespace:    namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/component":  "backup-reader",
+				"app.kubernetes.io/part-of":    "dbtree",
+				"app.kubernetes.io/managed-by": "dbtree-backend",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:        &deadline,
+			AutomountServiceAccountToken: &automount,
+			Containers: []corev1.Container{
+				{
+					Name:    "reader",
+					Image:   backupReaderImage,
+					Command: []string{"sleep", strconv.FormatInt(int64(backupReaderDeadline.Seconds()), 10)},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "backup-storage", MountPath: "/backup", ReadOnly: true},
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("32Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "backup-storage",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcName,
+							ReadOnly:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	created, err := c.clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to create backup reader pod")
+	}
+
+	phase, err := c.waitPodPhase(ctx, namespace, created.Name, backupReaderStartup,
+		corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed)
+	if err != nil {
+		c.deleteClientPod(namespace, created.Name)
+		if wait.Interrupted(err) {
+			return nil, 0, errors.NewCommandTimeoutError(backupReaderStartup)
+		}
+		return nil, 0, errors.Wrapf(err, "failed to wait for backup reader pod")
+	}
+	if phase != corev1.PodRunning {
+		c.deleteClientPod(namespace, created.Name)
+		return nil, 0, fmt.Errorf("backup reader pod %s is %s", created.Name, phase)
+	}
+
+	file := path.Join("/backup", archive)
+
+	// 아카이브가 없으면 stat 이 바로 실패함
+	var stdout, stderr bytes.Buffer
+	if err := c.execInPod(ctx, namespace, created.Name, "reader",
+		[]string{"stat", "-c", "%s", file}, &stdout, &stderr); err != nil {
+		c.deleteClientPod(namespace, created.Name)
+		return nil, 0, errors.NewCommandFailedError(tail(stderr.Bytes(), 512))
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(stdout.String()), 10, 64)
+	if err != nil {
+		c.deleteClientPod(namespace, created.Name)
+		return nil, 0, errors.Wrapf(err, "failed to read backup archive size")
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	reader := &backupArchiveReader{
+		client:    c,
+		namespace: namespace,
+		pod:       created.Name,
+		cancel:    cancel,
+		pipe:      pr,
+		size:      size,
+	}
+	go func() {
+		var stderr bytes.Buffer
+		err := c.execInPod(streamCtx, namespace, created.Name, "reader",
+			[]string{"cat", file}, pw, &stderr)
+		if err != nil {
+			err = fmt.Errorf("backup reader %s failed: %w (%s)", created.Name, err, tail(stderr.Bytes(), 512))
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return reader, size, nil
+}
+
+// backupArchiveReader 전송된 바이트 수를 파일 크기와 비교해 잘린 스트림을 에러로 돌려줌
+type backupArchiveReader struct {
+	client    *client
+	namespace string
+	pod       string
+	cancel    context.CancelFunc
+	pipe      *io.PipeReader
+	size      int64
+	read      int64
+}
+
+func (r *backupArchiveReader) Read(p []byte) (int, error) {
+	n, err := r.pipe.Read(p)
+	r.read += int64(n)
+	if r.read > r.size {
+		return n, fmt.Errorf("backup archive is larger than %d bytes", r.size)
+	}
+	if err == io.EOF && r.read != r.size {
+		return n, fmt.Errorf("backup archive truncated: %d of %d bytes", r.read, r.size)
+	}
+	return n, err
+}
+
+func (r *backupArchiveReader) Close() error {
+	r.cancel()
+	err := r.pipe.Close()
+	r.client.deleteClientPod(r.namespace, r.pod)
+	return err
+}
+
+// execInPod 실행 중인 컨테이너에서 command 를 실행하고 출력을 그대로 전달
+func (c *client) execInPod(ctx context.Context, namespace, pod, container string, command []string,
+	stdout, stderr io.Writer) error {
+	req := c.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	// 긴 스트림이 클라이언트 기본 타임아웃에 끊기지 않도록 해제
+	config := rest.CopyConfig(c.restConfig)
+	config.Timeout = 0
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// waitPodPhase 파드가 phases 중 하나가 될 때까지 대기
+func (c *client) waitPodPhase(ctx context.Context, namespace, name string, timeout time.Duration,
+	phases ...corev1.PodPhase) (corev1.PodPhase, error) {
+	var phase corev1.PodPhase
+	err := wait.PollUntilContextTimeout(ctx, commandPollInterval, timeout, true,
+		func(ctx context.Context) (bool, error) {
+			current, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			phase = current.Status.Phase
+			for _, p := range phases {
+				if phase == p {
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return phase, err
+}
@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"io"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -11,11 +12,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"log"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 
@@ -30,6 +33,8 @@ type Client interface {
 
 	CreateNamespace(ctx context.Context, name string) error
 	CreateSecret(ctx context.Context, namespace, name string, data map[string][]byte) error
+	// SecretData 없으면 nil
+	SecretData(ctx context.Context, namespace, name string) (map[string][]byte, error)
 	DeleteNamespace(ctx context.Context, name string) error
 
 	CreateDBInstance(ctx context.Context, namespace string, instance *unstructured.Unstructured) error
@@ -40,6 +45,29 @@ type Client interface {
 	PatchDBInstanceStatus(ctx context.Context, namespace, name string, state string, reason string) error
 
 	GetMongoDBStatus(ctx context.Context, namespace, name string) (*MongoDBStatus, error)
+	DBInstanceHistory(ctx context.Context, namespace, name string) ([]StatusTransition, error)
+
+	// SetUserLabels 사용자 라벨(UserLabelPrefix)만 교체, 시스템 라벨은 그대로
+	SetUserLabels(ctx context.Context, namespace, name string, labels map[string]string) error
+
+	SetAllowedSourceRanges(ctx context.Context, namespace, name string, ranges []string) error
+	SourceRangeStatus(ctx context.Context, namespace, name string) (*SourceRangeStatus, error)
+
+	RequestCredentialsRotation(ctx context.Context, namespace, name, secretName, rotationID, password string) error
+	CredentialsRotationStatus(ctx context.Context, namespace, name string) (*CredentialsRotationStatus, error)
+
+	InstancePods(ctx context.Context, namespace, instanceName string) ([]string, error)
+	StreamPodLogs(ctx context.Context, namespace, pod string, opts PodLogOptions) (io.ReadCloser, error)
+
+	SetProfiling(ctx context.Context, namespace, name string, profiling ProfilingSpec) error
+	RunCommandPod(ctx context.Context, namespace string, spec CommandPodSpec) ([]byte, error)
+	// OpenBackupArchive 백업 PVC 의 아카이브 스트림과 크기, Close 해야 헬퍼 파드가 지워짐
+	OpenBackupArchive(ctx context.Context, namespace, pvcName, archive string) (io.ReadCloser, int64, error)
+
+	StartDataImport(ctx context.Context, namespace, name string, spec DataImportSpec) error
+	DataImportStatus(ctx context.Context, namespace, name string) (*DataImportStatus, error)
+
+	WatchDBInstances(ctx context.Context, handler DBInstanceStatusHandler) error
 }
 
 type client struct {
@@ -47,6 +75,11 @@ type client struct {
 	dynamic    dynamic.Interface
 	restConfig *rest.Config
 	logger     *log.Logger
+
+	// WatchDBInstances 가 시작한 informer 캐시
+	informerMu         sync.RWMutex
+	dbInstanceInformer cache.SharedIndexInformer
+	dbInstanceLister   cache.GenericLister
 }
 
 var _ Client = (*client)(nil)
@@ -182,6 +215,17 @@ func (c *client) CreateSecret(ctx context.Context, namespace, name string, data
 	return nil
 }
 
+func (c *client) SecretData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	secret, err := c.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get secret %s/%s", namespace, name)
+	}
+	return secret.Data, nil
+}
+
 // DeleteNamespace deletes a namespace
 func (c *client) DeleteNamespace(ctx context.Context, name string) error {
 	err := c.clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
@@ -251,8 +295,12 @@ func (c *client) DeleteDBInstance(ctx context.Context, namespace, name string) e
 	return nil
 }
 
-// DBInstance gets a DBInstance CRD
+// DBInstance gets a DBInstance CRD (informer 캐시 우선)
 func (c *client) DBInstance(ctx context.Context, namespace, name string) (*unstructured.Unstructured, error) {
+	if cached, ok := c.cachedDBInstance(namespace, name); ok {
+		return cached, nil
+	}
+
 	instance, err := c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
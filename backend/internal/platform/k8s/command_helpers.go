@@ -0,0 +1,158 @@
+package k8s
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	defaultCommandTimeout     = 30 * time.Second
+	defaultCommandOutputBytes = 1 << 20 // 1MB
+	commandPollInterval       = 500 * time.Millisecond
+
+	// imagePullAllowance ActiveDeadlineSeconds 는 이미지 pull 시간도 포함하므로 여유를 둠
+	imagePullAllowance = 30 * time.Second
+)
+
+// CommandPodSpec 인스턴스 네임스페이스에서 한 번 실행하고 지우는 클라이언트 파드
+// 네임스페이스 안에서 실행되므로 allowlist NetworkPolicy 의 영향을 받지 않음
+type CommandPodSpec struct {
+	NamePrefix string
+	Image      string
+	Command    []string          // $(VAR) 형식으로 Env, Secret 값 참조 가능
+	Env        map[string]string // DB_HOST, DB_PORT 등, 값은 $(VAR) 치환 없이 그대로 전달
+	SecretName string            // envFrom 으로 주입할 접속 정보 Secret
+
+	Timeout        time.Duration // 0 이면 30초
+	MaxOutputBytes int64         // 0 이면 1MB, 초과분은 잘림
+}
+
+// RunCommandPod 파드를 만들어 명령을 실행하고 stdout 을 반환, 끝나면 파드 삭제
+// 명령이 실패하면 출력 일부를 담은 CommandFailed 에러 반환
+func (c *client) RunCommandPod(ctx context.Context, namespace string, spec CommandPodSpec) ([]byte, error) {
+	timeout := spec.Timeout
+	if timeout <= 0 {
+		timeout = defaultCommandTimeout
+	}
+	limitBytes := spec.MaxOutputBytes
+	if limitBytes <= 0 {
+		limitBytes = defaultCommandOutputBytes
+	}
+
+	env := make([]corev1.EnvVar, 0, len(spec.Env))
+	for name, value := range spec.Env {
+		env = append(env, corev1.EnvVar{Name: name, Value: strings.ReplaceAll(value, "$", "$$")})
+	}
+
+	var envFrom []corev1.EnvFromSource
+	if spec.SecretName != "" {
+		envFrom = append(envFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: spec.SecretName},
+			},
+		})
+	}
+
+	deadline := int64((timeout + imagePullAllowance).Seconds())
+	automount := false
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: spec.NamePrefix + "-",
+			Namespace:    namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/component":  "client",
+				"app.kubernetes.io/part-of":    "dbtree",
+				"app.kubernetes.io/managed-by": "dbtree-backend",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:        &deadline,
+			AutomountServiceAccountToken: &automount,
+			Containers: []corev1.Container{
+				{
+					Name:    "client",
+					Image:   spec.Image,
+					Command: spec.Command,
+					Env:     env,
+					EnvFrom: envFrom,
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	created, err := c.clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create command pod")
+	}
+
+	defer c.deleteClientPod(namespace, created.Name)
+
+	phase, err := c.waitPodPhase(ctx, namespace, created.Name, timeout+imagePullAllowance+5*time.Second,
+		corev1.PodSucceeded, corev1.PodFailed)
+	if err != nil {
+		if wait.Interrupted(err) {
+			return nil, errors.NewCommandTimeoutError(timeout)
+		}
+		return nil, errors.Wrapf(err, "failed to wait for command pod")
+	}
+
+	stream, err := c.clientset.CoreV1().Pods(namespace).GetLogs(created.Name, &corev1.PodLogOptions{
+		Container:  "client",
+		LimitBytes: &limitBytes,
+	}).Stream(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read command pod output")
+	}
+	defer stream.Close()
+
+	output, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read command pod output")
+	}
+
+	if phase == corev1.PodFailed {
+		// ActiveDeadlineSeconds 초과도 Failed 로 끝남
+		return nil, errors.NewCommandFailedError(tail(output, 512))
+	}
+
+	return output, nil
+}
+
+// deleteClientPod 요청 ctx 가 취소되어도 파드는 지움
+func (c *client) deleteClientPod(namespace, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	propagation := metav1.DeletePropagationBackground
+	if err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}); err != nil {
+		c.logger.Printf("클라이언트 파드 삭제 실패 (%s/%s): %v", namespace, name, err)
+	}
+}
+
+func tail(output []byte, n int) string {
+	if len(output) > n {
+		output = output[len(output)-n:]
+	}
+	return string(output)
+}
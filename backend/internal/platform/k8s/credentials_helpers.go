@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PendingPasswordKey 오퍼레이터가 적용할 새 비밀번호를 담는 Secret 키
+const PendingPasswordKey = "pending-password"
+
+// CredentialsRotationStatus spec/status.credentialsRotation 비교용
+type CredentialsRotationStatus struct {
+	Requested string
+	Applied   string
+	RotatedAt *time.Time
+}
+
+// InProgress 요청된 교체를 오퍼레이터가 아직 처리하지 않음
+func (s *CredentialsRotationStatus) InProgress() bool {
+	return s.Requested != "" && s.Requested != s.Applied
+}
+
+// RequestCredentialsRotation 새 비밀번호를 Secret 에 넣고 CRD 에 교체 요청 기록
+// 오퍼레이터가 운영 중인 DB 에 적용한 뒤 password 키를 교체함
+func (c *client) RequestCredentialsRotation(ctx context.Context, namespace, name, secretName, rotationID, password string) error {
+	secretPatch, err := json.Marshal(map[string]interface{}{
+		"stringData": map[string]string{
+			PendingPasswordKey: password,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "secret 패치 생성 실패")
+	}
+
+	if _, err := c.clientset.CoreV1().Secrets(namespace).
+		Patch(ctx, secretName, types.MergePatchType, secretPatch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to patch secret %s", secretName)
+	}
+
+	specPatch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"credentialsRotation": rotationID,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "DBInstance 패치 생성 실패")
+	}
+
+	if _, err := c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, specPatch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to patch DBInstance credentialsRotation")
+	}
+
+	return nil
+}
+
+func (c *client) CredentialsRotationStatus(ctx context.Context, namespace, name string) (*CredentialsRotationStatus, error) {
+	resource, err := c.DBInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "DBInstance 리소스 조회 실패")
+	}
+	if resource == nil {
+		return nil, errors.NewResourceNotFoundError("DBInstance", namespace+"/"+name)
+	}
+
+	return parseCredentialsRotationStatus(resource), nil
+}
+
+func parseCredentialsRotationStatus(resource *unstructured.Unstructured) *CredentialsRotationStatus {
+	result := &CredentialsRotationStatus{}
+	result.Requested, _, _ = unstructured.NestedString(resource.Object, "spec", "credentialsRotation")
+	result.Applied, _, _ = unstructured.NestedString(resource.Object, "status", "credentialsRotation")
+
+	rotatedAt, _, _ := unstructured.NestedFieldNoCopy(resource.Object, "status", "credentialsRotatedAt")
+	if t := parseTime(rotatedAt); !t.IsZero() {
+		result.RotatedAt = &t
+	}
+
+	return result
+}
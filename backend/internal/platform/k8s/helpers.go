@@ -18,6 +18,17 @@ type DBInstanceParams struct {
 	Backup            BackupSpec
 	Config            map[string]interface{}
 	ExternalPort      int32
+	ExternalHost      string   // SNI 게이트웨이 호스트명, 비어 있으면 게이트웨이 라우팅 없음
+	AllowedCIDRs      []string // 외부 접속 허용 CIDR, 비어 있으면 전체 허용
+	Profiling         *ProfilingSpec
+	DataImport        *DataImportSpec // 생성 직후 적재할 데이터 (샘플 데이터셋)
+	CloneFrom         *CloneSourceSpec
+}
+
+// CloneSourceSpec 복제 원본 (spec.cloneFrom), 같은 네임스페이스의 DBInstance
+type CloneSourceSpec struct {
+	Instance string // 원본 DBInstance 이름
+	Archive  string // 원본 백업 PVC 안의 아카이브, 비어 있으면 실행 중인 원본에서 복사
 }
 
 type ResourceSpec struct {
@@ -32,6 +43,32 @@ type BackupSpec struct {
 	RetentionDays int
 }
 
+// ToSpec CRD spec.resources
+func (r ResourceSpec) ToSpec() map[string]interface{} {
+	return map[string]interface{}{
+		"cpu":    ConvertCPUToString(r.CPU),
+		"memory": r.Memory,
+		"disk":   r.Disk,
+	}
+}
+
+// ToSpec CRD spec.backup, 비어 있는 값은 생략해 CRD 기본값 사용
+func (b BackupSpec) ToSpec() map[string]interface{} {
+	backupSpec := map[string]interface{}{
+		"enabled": b.Enabled,
+	}
+
+	if b.Schedule != "" {
+		backupSpec["schedule"] = b.Schedule
+	}
+
+	if b.RetentionDays > 0 {
+		backupSpec["retentionDays"] = b.RetentionDays
+	}
+
+	return backupSpec
+}
+
 // ConvertCPUToString converts float64 CPU to string for CRD
 func ConvertCPUToString(cpu float64) string {
 	if cpu < 1 {
@@ -52,11 +89,7 @@ func BuildDBInstanceSpec(params DBInstanceParams) map[string]interface{} {
 		"secretRef": map[string]interface{}{
 			"name": params.SecretRef,
 		},
-		"resources": map[string]interface{}{
-			"cpu":    ConvertCPUToString(params.Resources.CPU),
-			"memory": params.Resources.Memory,
-			"disk":   params.Resources.Disk,
-		},
+		"resources":    params.Resources.ToSpec(),
 		"userId":       params.UserID,
 		"externalPort": params.ExternalPort,
 	}
@@ -65,19 +98,37 @@ func BuildDBInstanceSpec(params DBInstanceParams) map[string]interface{} {
 		spec["createdFromPreset"] = *params.CreatedFromPreset
 	}
 
-	backupSpec := map[string]interface{}{
-		"enabled": params.Backup.Enabled,
+	if params.ExternalHost != "" {
+		spec["externalHost"] = params.ExternalHost
+	}
+
+	if len(params.AllowedCIDRs) > 0 {
+		ranges := make([]interface{}, len(params.AllowedCIDRs))
+		for i, cidr := range params.AllowedCIDRs {
+			ranges[i] = cidr
+		}
+		spec["allowedSourceRanges"] = ranges
+	}
+
+	if params.Profiling != nil {
+		spec["profiling"] = params.Profiling.toMap()
 	}
 
-	if params.Backup.Schedule != "" {
-		backupSpec["schedule"] = params.Backup.Schedule
+	if params.DataImport != nil {
+		spec["dataImport"] = params.DataImport.toMap()
 	}
 
-	if params.Backup.RetentionDays > 0 {
-		backupSpec["retentionDays"] = params.Backup.RetentionDays
+	if params.CloneFrom != nil {
+		cloneFrom := map[string]interface{}{
+			"instance": params.CloneFrom.Instance,
+		}
+		if params.CloneFrom.Archive != "" {
+			cloneFrom["archive"] = params.CloneFrom.Archive
+		}
+		spec["cloneFrom"] = cloneFrom
 	}
 
-	spec["backup"] = backupSpec
+	spec["backup"] = params.Backup.ToSpec()
 
 	if params.Config != nil {
 		spec["config"] = params.Config
@@ -0,0 +1,61 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// StatusTransition 오퍼레이터가 status.history 에 기록한 라이프사이클 전이
+type StatusTransition struct {
+	Time    time.Time
+	State   string
+	Type    string // Normal, Warning
+	Reason  string
+	Message string
+}
+
+func (c *client) DBInstanceHistory(ctx context.Context, namespace, name string) ([]StatusTransition, error) {
+	resource, err := c.DBInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "DBInstance 리소스 조회 실패")
+	}
+	if resource == nil {
+		return nil, errors.NewResourceNotFoundError("DBInstance", namespace+"/"+name)
+	}
+
+	return parseDBInstanceHistory(resource), nil
+}
+
+func parseDBInstanceHistory(resource *unstructured.Unstructured) []StatusTransition {
+	history, found, err := unstructured.NestedSlice(resource.Object, "status", "history")
+	if err != nil || !found {
+		return []StatusTransition{}
+	}
+
+	result := make([]StatusTransition, 0, len(history))
+	for _, entry := range history {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transition := StatusTransition{}
+		transition.State, _ = entryMap["state"].(string)
+		transition.Type, _ = entryMap["type"].(string)
+		transition.Reason, _ = entryMap["reason"].(string)
+		transition.Message, _ = entryMap["message"].(string)
+		if ts, ok := entryMap["time"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, ts); err == nil {
+				transition.Time = t
+			}
+		}
+
+		result = append(result, transition)
+	}
+
+	return result
+}
@@ -0,0 +1,104 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DataImportSpec 오퍼레이터 import Job 요청 (spec.dataImport)
+type DataImportSpec struct {
+	ID         string
+	Format     string
+	SourceURL  string // Job 이 파일을 내려받을 주소
+	Database   string
+	Collection string
+	Drop       bool
+}
+
+func (s *DataImportSpec) toMap() map[string]interface{} {
+	spec := map[string]interface{}{
+		"id":        s.ID,
+		"format":    s.Format,
+		"sourceUrl": s.SourceURL,
+		"drop":      s.Drop,
+	}
+	if s.Database != "" {
+		spec["database"] = s.Database
+	}
+	if s.Collection != "" {
+		spec["collection"] = s.Collection
+	}
+	return spec
+}
+
+// DataImportStatus spec.dataImport.id 와 status.dataImport 비교용
+type DataImportStatus struct {
+	Requested   string
+	ID          string
+	Phase       string // Running, Succeeded, Failed
+	Message     string
+	CompletedAt *time.Time
+}
+
+func (s *DataImportStatus) Finished() bool {
+	return s.Phase == "Succeeded" || s.Phase == "Failed"
+}
+
+// InProgress 요청된 import 를 오퍼레이터가 아직 끝내지 않음
+func (s *DataImportStatus) InProgress() bool {
+	return s.Requested != "" && (s.ID != s.Requested || !s.Finished())
+}
+
+// StartDataImport spec.dataImport 교체, 오퍼레이터가 import Job 을 만들어 적재함
+func (c *client) StartDataImport(ctx context.Context, namespace, name string, spec DataImportSpec) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"dataImport": spec.toMap(),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "dataImport 패치 생성 실패")
+	}
+
+	_, err = c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch DBInstance dataImport")
+	}
+
+	return nil
+}
+
+func (c *client) DataImportStatus(ctx context.Context, namespace, name string) (*DataImportStatus, error) {
+	resource, err := c.DBInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "DBInstance 리소스 조회 실패")
+	}
+	if resource == nil {
+		return nil, errors.NewResourceNotFoundError("DBInstance", namespace+"/"+name)
+	}
+
+	return parseDataImportStatus(resource), nil
+}
+
+func parseDataImportStatus(resource *unstructured.Unstructured) *DataImportStatus {
+	result := &DataImportStatus{}
+	result.Requested, _, _ = unstructured.NestedString(resource.Object, "spec", "dataImport", "id")
+	result.ID, _, _ = unstructured.NestedString(resource.Object, "status", "dataImport", "id")
+	result.Phase, _, _ = unstructured.NestedString(resource.Object, "status", "dataImport", "phase")
+	result.Message, _, _ = unstructured.NestedString(resource.Object, "status", "dataImport", "message")
+
+	completedAt, _, _ := unstructured.NestedFieldNoCopy(resource.Object, "status", "dataImport", "completedAt")
+	if t := parseTime(completedAt); !t.IsZero() {
+		result.CompletedAt = &t
+	}
+
+	return result
+}
@@ -0,0 +1,236 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	informerResyncPeriod = 10 * time.Minute
+	informerSyncTimeout  = 30 * time.Second
+)
+
+// Condition DBInstance status.conditions 항목
+type Condition struct {
+	Type               string
+	Status             string
+	Reason             string
+	Message            string
+	LastTransitionTime time.Time
+}
+
+// Metrics DBInstance status.metrics
+type Metrics struct {
+	CPUUsage            string
+	MemoryUsage         string
+	DiskUsage           string
+	Connections         int
+	OperationsPerSecond int
+	UpdatedAt           *time.Time
+}
+
+// DBInstanceStatus informer 가 전달하는 CRD status 스냅샷
+type DBInstanceStatus struct {
+	Namespace  string
+	Name       string
+	ExternalID string
+
+	State        string
+	StatusReason string
+	Endpoint     string
+	Port         int32
+	Conditions   []Condition
+	Metrics      *Metrics
+
+	// 마지막으로 처리된 비밀번호 교체 요청과 성공 시각
+	CredentialsRotation  string
+	CredentialsRotatedAt *time.Time
+
+	// 마지막 데이터 가져오기 요청의 진행 상태
+	DataImport *DataImportStatus
+}
+
+// DBInstanceStatusHandler status 가 바뀐 DBInstance 마다 호출됨 (informer 고루틴에서 순차 실행)
+type DBInstanceStatusHandler func(status *DBInstanceStatus)
+
+// WatchDBInstances 전체 네임스페이스의 DBInstance informer 시작
+// 캐시가 동기화되면 DBInstance, GetMongoDBStatus, DBInstanceHistory 는 API 서버 대신 캐시를 조회함
+// 동기화가 늦어지면 기다리지 않고 반환하며, 그동안 조회는 API 서버로 fallback
+func (c *client) WatchDBInstances(ctx context.Context, handler DBInstanceStatusHandler) error {
+	c.informerMu.Lock()
+	if c.dbInstanceInformer != nil {
+		c.informerMu.Unlock()
+		return fmt.Errorf("DBInstance informer 가 이미 실행 중입니다")
+	}
+
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(c.dynamic, informerResyncPeriod)
+	genericInformer := factory.ForResource(dbInstanceGVR)
+	informer := genericInformer.Informer()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if status := toDBInstanceStatus(obj); status != nil {
+				handler(status)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldStatus, newStatus := toDBInstanceStatus(oldObj), toDBInstanceStatus(newObj)
+			if newStatus == nil || reflect.DeepEqual(oldStatus, newStatus) {
+				return // resync 또는 spec 만 변경
+			}
+			handler(newStatus)
+		},
+	})
+	if err != nil {
+		c.informerMu.Unlock()
+		return errors.Wrapf(err, "DBInstance informer 핸들러 등록 실패")
+	}
+
+	factory.Start(ctx.Done())
+	c.dbInstanceInformer = informer
+	c.dbInstanceLister = genericInformer.Lister()
+	c.informerMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		factory.Shutdown()
+
+		c.informerMu.Lock()
+		c.dbInstanceInformer = nil
+		c.dbInstanceLister = nil
+		c.informerMu.Unlock()
+	}()
+
+	syncCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+	defer cancel()
+
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		c.logger.Printf("DBInstance informer 캐시 동기화 지연, 동기화 전까지 API 서버 조회")
+		return nil
+	}
+
+	c.logger.Printf("DBInstance informer 캐시 동기화 완료")
+	return nil
+}
+
+// cachedDBInstance 캐시 조회, 캐시를 쓸 수 없거나 캐시에 없으면 ok=false
+func (c *client) cachedDBInstance(namespace, name string) (*unstructured.Unstructured, bool) {
+	c.informerMu.RLock()
+	informer, lister := c.dbInstanceInformer, c.dbInstanceLister
+	c.informerMu.RUnlock()
+
+	if informer == nil || !informer.HasSynced() {
+		return nil, false
+	}
+
+	obj, err := lister.ByNamespace(namespace).Get(name)
+	if err != nil {
+		// 생성 직후라 아직 캐시에 없을 수 있음
+		return nil, false
+	}
+
+	resource, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+
+	// 캐시 객체는 공유되므로 복사본 반환
+	return resource.DeepCopy(), true
+}
+
+func toDBInstanceStatus(obj interface{}) *DBInstanceStatus {
+	resource, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	result := &DBInstanceStatus{
+		Namespace: resource.GetNamespace(),
+		Name:      resource.GetName(),
+	}
+	result.ExternalID, _, _ = unstructured.NestedString(resource.Object, "spec", "externalId")
+
+	if dataImport := parseDataImportStatus(resource); dataImport.ID != "" {
+		result.DataImport = dataImport
+	}
+
+	status, found, err := unstructured.NestedMap(resource.Object, "status")
+	if err != nil || !found {
+		return result
+	}
+
+	result.State, _, _ = unstructured.NestedString(status, "state")
+	result.StatusReason, _, _ = unstructured.NestedString(status, "statusReason")
+	result.Endpoint, _, _ = unstructured.NestedString(status, "endpoint")
+	if port, found, err := unstructured.NestedInt64(status, "port"); err == nil && found {
+		result.Port = int32(port)
+	}
+
+	result.CredentialsRotation, _, _ = unstructured.NestedString(status, "credentialsRotation")
+	if t := parseTime(status["credentialsRotatedAt"]); !t.IsZero() {
+		result.CredentialsRotatedAt = &t
+	}
+
+	if conditions, found, err := unstructured.NestedSlice(status, "conditions"); err == nil && found {
+		for _, condition := range conditions {
+			condMap, ok := condition.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			cond := Condition{}
+			cond.Type, _ = condMap["type"].(string)
+			cond.Status, _ = condMap["status"].(string)
+			cond.Reason, _ = condMap["reason"].(string)
+			cond.Message, _ = condMap["message"].(string)
+			cond.LastTransitionTime = parseTime(condMap["lastTransitionTime"])
+			result.Conditions = append(result.Conditions, cond)
+		}
+	}
+
+	if metrics, found, err := unstructured.NestedMap(status, "metrics"); err == nil && found {
+		result.Metrics = &Metrics{}
+		result.Metrics.CPUUsage, _ = metrics["cpuUsage"].(string)
+		result.Metrics.MemoryUsage, _ = metrics["memoryUsage"].(string)
+		result.Metrics.DiskUsage, _ = metrics["diskUsage"].(string)
+		result.Metrics.Connections = int(toInt64(metrics["connections"]))
+		result.Metrics.OperationsPerSecond = int(toInt64(metrics["operationsPerSecond"]))
+		if t := parseTime(status["lastMetricsUpdate"]); !t.IsZero() {
+			result.Metrics.UpdatedAt = &t
+		}
+	}
+
+	return result
+}
+
+func parseTime(v interface{}) time.Time {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
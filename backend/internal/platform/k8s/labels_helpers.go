@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UserLabelPrefix 사용자 라벨을 CRD 라벨로 옮길 때 붙이는 접두사, dbtree.cloud/user-id 등 시스템 라벨과 구분
+const UserLabelPrefix = "label.dbtree.cloud/"
+
+// UserLabels 사용자 라벨에 접두사를 붙인 CRD 라벨
+func UserLabels(labels map[string]string) map[string]string {
+	crdLabels := make(map[string]string, len(labels))
+	for k, v := range labels {
+		crdLabels[UserLabelPrefix+k] = v
+	}
+	return crdLabels
+}
+
+func (c *client) SetUserLabels(ctx context.Context, namespace, name string, labels map[string]string) error {
+	resource, err := c.DBInstance(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		return errors.NewResourceNotFoundError("DBInstance", name)
+	}
+
+	// merge patch 에서 null 은 삭제
+	patchLabels := make(map[string]interface{})
+	for k := range resource.GetLabels() {
+		if strings.HasPrefix(k, UserLabelPrefix) {
+			patchLabels[k] = nil
+		}
+	}
+	for k, v := range UserLabels(labels) {
+		patchLabels[k] = v
+	}
+	if len(patchLabels) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": patchLabels,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "라벨 패치 생성 실패")
+	}
+
+	_, err = c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.NewResourceNotFoundError("DBInstance", name)
+		}
+		return errors.Wrapf(err, "failed to patch DBInstance labels")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package k8s
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodLogOptions 파드 로그 조회 옵션
+type PodLogOptions struct {
+	Container string
+	Follow    bool
+	Since     *time.Time
+	TailLines *int64
+	Previous  bool // 재시작 전 컨테이너 로그 (CrashLoop 원인 확인용)
+}
+
+// InstancePods 인스턴스의 DB 파드 이름 (이름순, StatefulSet 순번 0 이 먼저)
+func (c *client) InstancePods(ctx context.Context, namespace, instanceName string) ([]string, error) {
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/instance=" + instanceName + ",app.kubernetes.io/component=database",
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods for %s", instanceName)
+	}
+
+	names := make([]string, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// StreamPodLogs 파드 로그 스트림, Follow 면 ctx 가 취소될 때까지 열려 있음
+func (c *client) StreamPodLogs(ctx context.Context, namespace, pod string, opts PodLogOptions) (io.ReadCloser, error) {
+	logOptions := &corev1.PodLogOptions{
+		Container:  opts.Container,
+		Follow:     opts.Follow,
+		TailLines:  opts.TailLines,
+		Previous:   opts.Previous,
+		Timestamps: true,
+	}
+	if opts.Since != nil {
+		since := metav1.NewTime(*opts.Since)
+		logOptions.SinceTime = &since
+	}
+
+	stream, err := c.clientset.CoreV1().Pods(namespace).GetLogs(pod, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stream logs for pod %s", pod)
+	}
+	return stream, nil
+}
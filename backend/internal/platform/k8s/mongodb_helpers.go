@@ -20,10 +20,13 @@ type MongoDBStatus struct {
 }
 
 func (c *client) GetMongoDBStatus(ctx context.Context, namespace, name string) (*MongoDBStatus, error) {
-	resource, err := c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	resource, err := c.DBInstance(ctx, namespace, name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "DBInstance 리소스 조회 실패")
 	}
+	if resource == nil {
+		return nil, errors.NewResourceNotFoundError("DBInstance", namespace+"/"+name)
+	}
 
 	// DBInstance CRD에서 상태 파싱
 	status := parseDBInstanceStatus(resource)
@@ -0,0 +1,47 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ProfilingSpec 느린 작업 수집 설정 (spec.profiling)
+type ProfilingSpec struct {
+	Enabled           bool
+	SlowOpThresholdMs int
+}
+
+func (p *ProfilingSpec) toMap() map[string]interface{} {
+	profiling := map[string]interface{}{
+		"enabled": p.Enabled,
+	}
+	if p.SlowOpThresholdMs > 0 {
+		profiling["slowOpThresholdMs"] = p.SlowOpThresholdMs
+	}
+	return profiling
+}
+
+// SetProfiling spec.profiling 교체, 오퍼레이터가 설정 파일을 다시 만들고 롤링 재시작함
+func (c *client) SetProfiling(ctx context.Context, namespace, name string, profiling ProfilingSpec) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"profiling": profiling.toMap(),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "profiling 패치 생성 실패")
+	}
+
+	_, err = c.dynamic.Resource(dbInstanceGVR).Namespace(namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch DBInstance profiling")
+	}
+
+	return nil
+}
@@ -13,12 +13,14 @@ import (
 
 type AuthMiddleware struct {
 	authService auth.Service
+	adminEmail  string
 	logger      *log.Logger
 }
 
-func NewAuthMiddleware(authService auth.Service, logger *log.Logger) *AuthMiddleware {
+func NewAuthMiddleware(authService auth.Service, adminEmail string, logger *log.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
 		authService: authService,
+		adminEmail:  adminEmail,
 		logger:      logger,
 	}
 }
@@ -50,6 +52,23 @@ func (m *AuthMiddleware) RequireAuth(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// RequireAdmin 로그인 사용자 중 ADMIN_EMAIL 계정만 허용 (프리셋 공개 심사 등)
+func (m *AuthMiddleware) RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return m.RequireAuth(func(w http.ResponseWriter, r *http.Request) {
+		u, err := GetUserFromContext(r.Context())
+		if err != nil {
+			HandleError(w, err, m.logger)
+			return
+		}
+		// 로그인은 되어 있으므로 401 이 아닌 403, 클라이언트가 세션 만료로 오해하지 않게 함
+		if m.adminEmail == "" || !strings.EqualFold(u.Email, m.adminEmail) {
+			HandleError(w, errors.NewForbiddenError(), m.logger)
+			return
+		}
+		next(w, r)
+	})
+}
+
 func GetUserFromContext(ctx context.Context) (*user.User, error) {
 	u, ok := ctx.Value(corecontext.UserKey).(*user.User)
 	if !ok {
@@ -30,8 +30,8 @@ func NewCORSMiddleware(config CORSConfig) func(http.Handler) http.Handler {
 			if allowOrigin != "" {
 				w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
 				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, X-Requested-With")
-				w.Header().Set("Access-Control-Expose-Headers", "Retry-After, Content-Length")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, X-Requested-With, Idempotency-Key")
+				w.Header().Set("Access-Control-Expose-Headers", "Retry-After, Content-Length, Idempotent-Replayed")
 
 				// Only set credentials if not using wildcard
 				if config.AllowCredentials && allowOrigin != "*" {
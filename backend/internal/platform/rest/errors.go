@@ -18,15 +18,21 @@ func errorCodeToStatusCode(code errors.ErrorCode) int {
 	case errors.ErrMethodNotAllowed:
 		return http.StatusMethodNotAllowed
 
+	case errors.ErrIdempotencyKeyReused:
+		return http.StatusUnprocessableEntity
+
 	case errors.ErrInvalidOTP, errors.ErrExpiredOTP, errors.ErrSessionNotFound,
 		errors.ErrInvalidToken, errors.ErrUnauthorized:
 		return http.StatusUnauthorized
 
+	case errors.ErrForbidden:
+		return http.StatusForbidden
+
 	case errors.ErrSessionExpired, errors.ErrAlreadyVerified,
 		errors.ErrResourceConflict, errors.ErrInsufficientLemons, errors.ErrHarvestCooldown,
 		errors.ErrLemonStorageFull, errors.ErrNoQuizInProgress, errors.ErrHarvestAlreadyProcessed,
 		errors.ErrLemonAlreadyHarvested, errors.ErrInvalidStatusTransition, errors.ErrInstanceQuotaExceeded,
-		errors.ErrLimitExceeded:
+		errors.ErrLimitExceeded, errors.ErrRequestInProgress:
 		return http.StatusConflict
 
 	case errors.ErrResourceNotFound, errors.ErrEndpointNotFound:
@@ -41,6 +47,12 @@ func errorCodeToStatusCode(code errors.ErrorCode) int {
 	case errors.ErrInstanceNotReady:
 		return http.StatusServiceUnavailable
 
+	case errors.ErrCommandFailed:
+		return http.StatusBadGateway
+
+	case errors.ErrCommandTimeout:
+		return http.StatusGatewayTimeout
+
 	case errors.ErrResourceExhausted, errors.ErrSystemCapacity:
 		return http.StatusInsufficientStorage
 
@@ -76,7 +88,8 @@ func HandleError(w http.ResponseWriter, err error, logger *log.Logger) {
 
 	}
 
-	if domainErr.Code() == errors.ErrTooEarlyResend || domainErr.Code() == errors.ErrTooManyResends {
+	if domainErr.Code() == errors.ErrTooEarlyResend || domainErr.Code() == errors.ErrTooManyResends ||
+		domainErr.Code() == errors.ErrRequestInProgress {
 		if data := domainErr.ErrorData(); data != nil {
 			if m, ok := data.(map[string]int); ok {
 				if seconds, ok := m["waitSeconds"]; ok {
@@ -3,8 +3,10 @@ package rest
 import (
 	"encoding/json"
 	"log"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
 )
@@ -52,3 +54,54 @@ func GetBoolQuery(r *http.Request, key string, defaultVal bool) bool {
 		return defaultVal
 	}
 }
+
+// ClientIPResolver 감사 기록용 요청자 주소, 신뢰하는 프록시에서 온 요청만 전달 헤더를 따름
+type ClientIPResolver struct {
+	trustedProxies []*net.IPNet
+}
+
+func NewClientIPResolver(trustedProxies []*net.IPNet) *ClientIPResolver {
+	return &ClientIPResolver{trustedProxies: trustedProxies}
+}
+
+// ClientIP 직접 연결한 주소가 신뢰하는 프록시면 X-Forwarded-For 를 오른쪽부터 따라가
+// 처음 만나는 신뢰하지 않는 주소, 아니면 RemoteAddr
+func (c *ClientIPResolver) ClientIP(r *http.Request) string {
+	remote, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remote = r.RemoteAddr
+	}
+	if !c.trusted(remote) {
+		return remote
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(hops[i])
+			if net.ParseIP(ip) == nil {
+				break
+			}
+			if !c.trusted(ip) {
+				return ip
+			}
+		}
+	}
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return remote
+}
+
+func (c *ClientIPResolver) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range c.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
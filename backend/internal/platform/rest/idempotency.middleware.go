@@ -0,0 +1,203 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBody  = 1 << 20 // 1MB, 지문 계산용으로 읽는 최대 크기
+	maxIdempotentResponseBody = 1 << 20 // 1MB, 넘으면 응답을 저장하지 않음
+
+	// IdempotencyRecordTTL 완료된 응답 보관 기간
+	IdempotencyRecordTTL = 24 * time.Hour
+	// idempotencyLockTTL 처리 중 표시 유지 시간, 서버가 죽어도 이 시간이 지나면 다시 시도 가능
+	idempotencyLockTTL = 5 * time.Minute
+)
+
+// IdempotencyRecord 키별로 저장하는 요청 지문과 응답
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"statusCode,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+type IdempotencyStore interface {
+	// Reserve 키가 없으면 처리 중으로 기록하고 nil, 있으면 기존 기록 반환
+	Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+// IdempotencyMiddleware Idempotency-Key 헤더가 있는 요청을 한 번만 처리하고, 재시도에는 저장된 응답을 재전송
+// 레몬 차감이나 리소스 생성처럼 재시도하면 중복되는 요청에 사용, RequireAuth 안쪽에 둠
+type IdempotencyMiddleware struct {
+	store  IdempotencyStore
+	logger *log.Logger
+}
+
+func NewIdempotencyMiddleware(store IdempotencyStore, logger *log.Logger) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		store:  store,
+		logger: logger,
+	}
+}
+
+func (m *IdempotencyMiddleware) Idempotent(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			HandleError(w, errors.NewInvalidParameterError(IdempotencyKeyHeader, "키가 너무 깁니다"), m.logger)
+			return
+		}
+
+		user, err := GetUserFromContext(r.Context())
+		if err != nil {
+			HandleError(w, err, m.logger)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBody+1))
+		if err != nil {
+			HandleError(w, errors.NewInvalidParameterError("body", "요청 본문을 읽을 수 없습니다"), m.logger)
+			return
+		}
+		if len(body) > maxIdempotentRequestBody {
+			HandleError(w, errors.NewInvalidParameterError("body", "Idempotency-Key 요청 본문이 너무 큽니다"), m.logger)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// 사용자별로 키 공간을 나눔
+		storeKey := user.ID + ":" + key
+		fingerprint := requestFingerprint(r, body)
+
+		existing, err := m.store.Reserve(r.Context(), storeKey, fingerprint, idempotencyLockTTL)
+		if err != nil {
+			// 중복 처리 여부를 확인할 수 없으면 처리하지 않음
+			HandleError(w, errors.Wrap(err), m.logger)
+			return
+		}
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				HandleError(w, errors.NewIdempotencyKeyReusedError(key), m.logger)
+			case !existing.Completed:
+				HandleError(w, errors.NewRequestInProgressError(5), m.logger)
+			default:
+				replayResponse(w, existing)
+			}
+			return
+		}
+
+		recorder := &idempotencyRecorder{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+			buffer:         &bytes.Buffer{},
+		}
+		completed := false
+		defer func() {
+			if !completed {
+				// panic 등으로 응답을 저장하지 못하면 재시도할 수 있게 해제
+				m.release(storeKey)
+			}
+		}()
+
+		next(recorder, r)
+
+		// 서버 오류는 저장하지 않고 재시도 허용 (실패한 생성은 환불/롤백됨)
+		if recorder.statusCode >= http.StatusInternalServerError || recorder.overflow {
+			return
+		}
+
+		record := &IdempotencyRecord{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			StatusCode:  recorder.statusCode,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.buffer.Bytes(),
+		}
+		// 클라이언트가 연결을 끊어도 응답은 저장
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.store.Complete(ctx, storeKey, record, IdempotencyRecordTTL); err != nil {
+			m.logger.Printf("Idempotency 응답 저장 실패 (%s): %v", key, err)
+			return
+		}
+		completed = true
+	}
+}
+
+func (m *IdempotencyMiddleware) release(storeKey string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := m.store.Release(ctx, storeKey); err != nil {
+		m.logger.Printf("Idempotency 키 해제 실패: %v", err)
+	}
+}
+
+// requestFingerprint 같은 키로 다른 요청을 보냈는지 구분, 메서드/경로/본문 기준
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replayResponse(w http.ResponseWriter, record *IdempotencyRecord) {
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	buffer     *bytes.Buffer
+	overflow   bool
+}
+
+func (r *idempotencyRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *idempotencyRecorder) Write(b []byte) (int, error) {
+	if !r.overflow {
+		if r.buffer.Len()+len(b) > maxIdempotentResponseBody {
+			r.overflow = true
+			r.buffer.Reset()
+		} else {
+			r.buffer.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap http.ResponseController 가 원래 writer 에 전달되도록 함
+func (r *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -14,9 +14,13 @@ import (
 	corecontext "github.com/piper-hyowon/dBtree/internal/core/context"
 )
 
+// maxLogBodySize 디버그 로그에 남기는 응답 바디 최대 크기 (4KB)
+const maxLogBodySize = 4096
+
 type responseRecorder struct {
 	http.ResponseWriter
 	statusCode int
+	size       int
 	buffer     *bytes.Buffer
 }
 
@@ -26,8 +30,18 @@ func (r *responseRecorder) WriteHeader(statusCode int) {
 }
 
 func (r *responseRecorder) Write(b []byte) (int, error) {
-	r.buffer.Write(b)
-	return r.ResponseWriter.Write(b)
+	// 로그 스트림처럼 긴 응답은 앞부분만 보관
+	if remaining := maxLogBodySize - r.buffer.Len(); remaining > 0 {
+		r.buffer.Write(b[:min(len(b), remaining)])
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.size += n
+	return n, err
+}
+
+// Unwrap http.ResponseController 의 Flush, SetWriteDeadline 이 원래 writer 에 전달되도록 함
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
 }
 
 func LoggingMiddleware(logger *log.Logger, debugLogging bool) func(http.Handler) http.Handler {
@@ -68,18 +82,17 @@ func LoggingMiddleware(logger *log.Logger, debugLogging bool) func(http.Handler)
 
 			logger.Printf("[%s] ← %d (%d bytes, %.2f ms)",
 				requestID, recorder.statusCode,
-				recorder.buffer.Len(), durationMs)
+				recorder.size, durationMs)
 
 			// 응답 바디(디버그 모드)
 			if debugLogging {
 				responseBody := recorder.buffer.String()
-				responseBodyLength := recorder.buffer.Len()
+				responseBodyLength := recorder.size
 
 				if responseBodyLength > 0 {
-					const maxLogSize = 4096 // 4KB 제한
-					if responseBodyLength > maxLogSize {
+					if responseBodyLength > maxLogBodySize {
 						logger.Printf("[%s] RESPONSE BODY: (First %d of %d bytes)%s...",
-							requestID, maxLogSize, responseBodyLength, responseBody[:maxLogSize])
+							requestID, maxLogBodySize, responseBodyLength, responseBody)
 					} else {
 						logger.Printf("[%s] RESPONSE BODY: %s", requestID, responseBody)
 					}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
 )
@@ -18,11 +19,16 @@ const (
         cpu, memory, disk,
         creation_cost, hourly_cost,
         status, status_reason,
-        k8s_namespace, k8s_resource_name,
+        k8s_namespace, k8s_resource_name, k8s_secret_ref,
         endpoint, port,
         config,
         backup_enabled, backup_schedule, backup_retention_days,
-        created_at, updated_at, last_billed_at, paused_at, deleted_at
+        created_at, updated_at, last_billed_at, paused_at, deleted_at,
+        k8s_conditions, k8s_metrics, k8s_synced_at,
+        allowed_cidrs, profiling,
+        idle_policy, idle_since,
+        purge_after, purged_at,
+        labels, description
     `
 
 	selectInstancesQuery = "SELECT " + instanceColumns + " FROM db_instances"
@@ -48,57 +54,69 @@ func NewDBInstanceStore(db *sql.DB) dbservice.DBInstanceStore {
 
 func (s *DBInstanceStore) Create(ctx context.Context, instance *dbservice.DBInstance) error {
 	return withTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
-		query := `
-            INSERT INTO db_instances (
-                external_id, user_id, name, type, size, mode,
-                created_from_preset,
-                cpu, memory, disk,
-                creation_cost, hourly_cost,
-                status, config,
-                backup_enabled, backup_schedule, backup_retention_days,
-                k8s_namespace, k8s_resource_name
-            ) VALUES (
-                $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
-                $11, $12, $13, $14, $15, $16, $17, $18, $19
-            ) RETURNING id, created_at, updated_at
-        `
+		return insertInstance(ctx, tx, instance)
+	})
+}
 
-		configJSON, err := json.Marshal(instance.Config)
-		if err != nil {
-			return fmt.Errorf("marshal config: %w", err)
-		}
+// insertInstance 생성된 id/시각을 instance 에 채움, 사가 저장소와 공유
+func insertInstance(ctx context.Context, tx *sql.Tx, instance *dbservice.DBInstance) error {
+	query := `
+        INSERT INTO db_instances (
+            external_id, user_id, name, type, size, mode,
+            created_from_preset,
+            cpu, memory, disk,
+            creation_cost, hourly_cost,
+            status, config,
+            backup_enabled, backup_schedule, backup_retention_days,
+            k8s_namespace, k8s_resource_name,
+            labels, description
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
+            $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+        ) RETURNING id, created_at, updated_at
+    `
 
-		err = tx.QueryRowContext(ctx, query,
-			instance.ExternalID,
-			instance.UserID,
-			instance.Name,
-			instance.Type,
-			instance.Size,
-			instance.Mode,
-			instance.CreatedFromPreset,
-			instance.Resources.CPU,
-			instance.Resources.Memory,
-			instance.Resources.Disk,
-			instance.Cost.CreationCost,
-			instance.Cost.HourlyLemons,
-			instance.Status,
-			configJSON,
-			instance.BackupConfig.Enabled,
-			toNullString(instance.BackupConfig.Schedule),
-			toNullInt32(instance.BackupConfig.RetentionDays),
-			instance.K8sNamespace,
-			instance.K8sResourceName,
-		).Scan(&instance.ID, &instance.CreatedAt, &instance.UpdatedAt)
+	configJSON, err := json.Marshal(instance.Config)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	labelsJSON, err := marshalLabels(instance.Labels)
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			if isUniqueViolation(err, "unique_user_instance_name") {
-				return errors.NewInstanceNameConflictError(instance.Name)
-			}
-			return errors.Wrap(err)
+	err = tx.QueryRowContext(ctx, query,
+		instance.ExternalID,
+		instance.UserID,
+		instance.Name,
+		instance.Type,
+		instance.Size,
+		instance.Mode,
+		instance.CreatedFromPreset,
+		instance.Resources.CPU,
+		instance.Resources.Memory,
+		instance.Resources.Disk,
+		instance.Cost.CreationCost,
+		instance.Cost.HourlyLemons,
+		instance.Status,
+		configJSON,
+		instance.BackupConfig.Enabled,
+		toNullString(instance.BackupConfig.Schedule),
+		toNullInt32(instance.BackupConfig.RetentionDays),
+		instance.K8sNamespace,
+		instance.K8sResourceName,
+		labelsJSON,
+		toNullString(instance.Description),
+	).Scan(&instance.ID, &instance.CreatedAt, &instance.UpdatedAt)
+
+	if err != nil {
+		if isUniqueViolation(err, "unique_user_instance_name") || isUniqueViolation(err, "unique_user_live_instance_name") {
+			return errors.NewInstanceNameConflictError(instance.Name)
 		}
+		return errors.Wrap(err)
+	}
 
-		return nil
-	})
+	return nil
 }
 
 func (s *DBInstanceStore) Find(ctx context.Context, externalID string) (*dbservice.DBInstance, error) {
@@ -116,8 +134,25 @@ func (s *DBInstanceStore) Find(ctx context.Context, externalID string) (*dbservi
 	return instance, nil
 }
 
+func (s *DBInstanceStore) FindByID(ctx context.Context, id int64) (*dbservice.DBInstance, error) {
+	query := selectInstancesQuery + " WHERE id = $1 AND deleted_at IS NULL"
+
+	row := s.db.QueryRowContext(ctx, query, id)
+	instance, err := scanInstance(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find instance by id: %w", err)
+	}
+
+	return instance, nil
+}
+
 func (s *DBInstanceStore) FindByUserAndName(ctx context.Context, userID, name string) (*dbservice.DBInstance, error) {
-	query := selectInstancesQuery + " WHERE user_id = $1 AND name = $2 AND deleted_at IS NULL"
+	// 휴지통 인스턴스도 K8s 리소스를 같은 이름으로 갖고 있으므로 정리 전까지 이름을 점유
+	query := selectInstancesQuery + " WHERE user_id = $1 AND name = $2 AND (deleted_at IS NULL OR (" +
+		recycleBinCondition + ")) ORDER BY deleted_at NULLS FIRST LIMIT 1"
 
 	row := s.db.QueryRowContext(ctx, query, userID, name)
 	instance, err := scanInstance(row)
@@ -185,6 +220,7 @@ func (s *DBInstanceStore) Update(ctx context.Context, instance *dbservice.DBInst
             status_reason = $8,  
             last_billed_at = $9,  
             paused_at = $10,      
+            k8s_secret_ref = $11,
             updated_at = NOW()
         WHERE id = $1 AND deleted_at IS NULL
     `
@@ -200,6 +236,7 @@ func (s *DBInstanceStore) Update(ctx context.Context, instance *dbservice.DBInst
 		instance.StatusReason,
 		instance.LastBilledAt,
 		instance.PausedAt,
+		toNullString(instance.K8sSecretRef),
 	)
 
 	if err != nil {
@@ -242,6 +279,200 @@ func (s *DBInstanceStore) UpdateStatus(ctx context.Context, id int64, status dbs
 	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
 }
 
+func (s *DBInstanceStore) UpdateSpec(ctx context.Context, instance *dbservice.DBInstance, priceChange *dbservice.PriceChange) error {
+	configJSON, err := json.Marshal(instance.Config)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+
+	return withTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+            UPDATE db_instances SET
+                size = $2,
+                cpu = $3,
+                memory = $4,
+                disk = $5,
+                hourly_cost = $6,
+                config = $7,
+                backup_enabled = $8,
+                backup_schedule = $9,
+                backup_retention_days = $10
+            WHERE id = $1 AND deleted_at IS NULL
+        `,
+			instance.ID,
+			instance.Size,
+			instance.Resources.CPU,
+			instance.Resources.Memory,
+			instance.Resources.Disk,
+			instance.Cost.HourlyLemons,
+			configJSON,
+			instance.BackupConfig.Enabled,
+			toNullString(instance.BackupConfig.Schedule),
+			toNullInt32(instance.BackupConfig.RetentionDays),
+		)
+		if err != nil {
+			return fmt.Errorf("update instance spec: %w", err)
+		}
+		if err := checkRowsAffected(result, "instance", fmt.Sprintf("%d", instance.ID)); err != nil {
+			return err
+		}
+
+		if priceChange == nil {
+			return nil
+		}
+
+		err = tx.QueryRowContext(ctx, `
+            INSERT INTO db_instance_price_changes (
+                db_instance_id, previous_size, new_size,
+                previous_hourly_cost, new_hourly_cost, effective_from
+            ) VALUES (
+                $1, $2, $3, $4, $5, $6
+            ) RETURNING created_at
+        `,
+			priceChange.InstanceID,
+			priceChange.PreviousSize,
+			priceChange.NewSize,
+			priceChange.PreviousHourlyLemons,
+			priceChange.NewHourlyLemons,
+			priceChange.EffectiveFrom,
+		).Scan(&priceChange.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("record price change: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *DBInstanceStore) UpdateAllowedCIDRs(ctx context.Context, id int64, cidrs []string) error {
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+	cidrsJSON, err := json.Marshal(cidrs)
+	if err != nil {
+		return fmt.Errorf("marshal allowed cidrs: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET allowed_cidrs = $2
+        WHERE id = $1 AND deleted_at IS NULL
+    `, id, cidrsJSON)
+	if err != nil {
+		return fmt.Errorf("update allowed cidrs: %w", err)
+	}
+
+	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
+}
+
+func (s *DBInstanceStore) UpdateProfiling(ctx context.Context, id int64, profiling *dbservice.ProfilingConfig) error {
+	var profilingJSON interface{} // nil 이면 NULL
+	if profiling != nil {
+		data, err := json.Marshal(profiling)
+		if err != nil {
+			return fmt.Errorf("marshal profiling: %w", err)
+		}
+		profilingJSON = data
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET profiling = $2
+        WHERE id = $1 AND deleted_at IS NULL
+    `, id, profilingJSON)
+	if err != nil {
+		return fmt.Errorf("update profiling: %w", err)
+	}
+
+	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
+}
+
+func (s *DBInstanceStore) UpdateIdlePolicy(ctx context.Context, id int64, policy *dbservice.IdlePolicy) error {
+	var policyJSON interface{} // nil 이면 NULL
+	if policy != nil {
+		data, err := json.Marshal(policy)
+		if err != nil {
+			return fmt.Errorf("marshal idle policy: %w", err)
+		}
+		policyJSON = data
+	}
+
+	// 정책이 바뀌면 유휴 시간은 처음부터 다시 측정
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET idle_policy = $2, idle_since = NULL
+        WHERE id = $1 AND deleted_at IS NULL
+    `, id, policyJSON)
+	if err != nil {
+		return fmt.Errorf("update idle policy: %w", err)
+	}
+
+	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
+}
+
+func (s *DBInstanceStore) UpdateIdleSince(ctx context.Context, id int64, since *time.Time) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE db_instances SET idle_since = $2 WHERE id = $1`, id, toNullTime(since))
+	if err != nil {
+		return fmt.Errorf("update idle since: %w", err)
+	}
+	return nil
+}
+
+func (s *DBInstanceStore) ListIdlePolicyEnabled(ctx context.Context) ([]*dbservice.DBInstance, error) {
+	query := selectInstancesQuery + `
+        WHERE status = 'running' AND deleted_at IS NULL
+          AND idle_policy IS NOT NULL AND (idle_policy->>'enabled')::boolean
+        ORDER BY id
+    `
+	return s.queryInstances(ctx, query)
+}
+
+func (s *DBInstanceStore) SyncK8sStatus(ctx context.Context, externalID string, status *dbservice.K8sStatus) error {
+	conditions := status.Conditions
+	if conditions == nil {
+		conditions = []dbservice.K8sCondition{}
+	}
+	conditionsJSON, err := json.Marshal(conditions)
+	if err != nil {
+		return fmt.Errorf("marshal conditions: %w", err)
+	}
+
+	var metricsJSON interface{} // 메트릭이 없으면 NULL
+	if status.Metrics != nil {
+		b, err := json.Marshal(status.Metrics)
+		if err != nil {
+			return fmt.Errorf("marshal metrics: %w", err)
+		}
+		metricsJSON = b
+	}
+
+	// 연결 정보는 오퍼레이터가 아직 채우지 않았으면 기존 값 유지
+	query := `
+        UPDATE db_instances SET
+            status = $2,
+            status_reason = $3,
+            endpoint = COALESCE($4, endpoint),
+            port = COALESCE($5, port),
+            k8s_conditions = $6,
+            k8s_metrics = $7,
+            k8s_synced_at = NOW()
+        WHERE external_id = $1 AND deleted_at IS NULL AND status <> 'deleting'
+    `
+
+	_, err = s.db.ExecContext(ctx, query,
+		externalID,
+		status.Status,
+		status.StatusReason,
+		toNullString(status.Endpoint),
+		toNullInt32(status.Port),
+		conditionsJSON,
+		metricsJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("sync k8s status: %w", err)
+	}
+
+	return nil
+}
+
 func (s *DBInstanceStore) UpdateBillingTime(ctx context.Context, id int64, billedAt time.Time) error {
 	query := `
         UPDATE db_instances SET
@@ -274,6 +505,94 @@ func (s *DBInstanceStore) Delete(ctx context.Context, externalID string) error {
 	return checkRowsAffected(result, "instance", externalID)
 }
 
+// recycleBinCondition 휴지통에 있는 인스턴스 (영구 삭제 요청이나 레몬 부족 삭제는 purge_after 가 없음)
+const recycleBinCondition = "deleted_at IS NOT NULL AND purged_at IS NULL AND purge_after IS NOT NULL"
+
+func (s *DBInstanceStore) FindIncludingDeleted(ctx context.Context, externalID string) (*dbservice.DBInstance, error) {
+	row := s.db.QueryRowContext(ctx, selectInstancesQuery+" WHERE external_id = $1", externalID)
+	instance, err := scanInstance(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find instance including deleted: %w", err)
+	}
+
+	return instance, nil
+}
+
+func (s *DBInstanceStore) FindDeleted(ctx context.Context, externalID string) (*dbservice.DBInstance, error) {
+	row := s.db.QueryRowContext(ctx,
+		selectInstancesQuery+" WHERE external_id = $1 AND "+recycleBinCondition, externalID)
+	instance, err := scanInstance(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find deleted instance: %w", err)
+	}
+
+	return instance, nil
+}
+
+func (s *DBInstanceStore) ListPurgeDue(ctx context.Context, now time.Time, limit int) ([]*dbservice.DBInstance, error) {
+	query := selectInstancesQuery + " WHERE " + recycleBinCondition + " AND purge_after <= $1 ORDER BY purge_after LIMIT $2"
+	return s.queryInstances(ctx, query, now, limit)
+}
+
+func (s *DBInstanceStore) SoftDelete(ctx context.Context, id int64, purgeAfter time.Time) error {
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET
+            status = $2,
+            status_reason = $3,
+            deleted_at = NOW(),
+            purge_after = $4,
+            idle_since = NULL,
+            updated_at = NOW()
+        WHERE id = $1 AND deleted_at IS NULL
+    `, id, dbservice.StatusStopped, dbservice.RecycleBinReason, purgeAfter)
+	if err != nil {
+		return fmt.Errorf("soft delete instance: %w", err)
+	}
+
+	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
+}
+
+func (s *DBInstanceStore) Undelete(ctx context.Context, instance *dbservice.DBInstance) error {
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET
+            status_reason = $2,
+            deleted_at = NULL,
+            purge_after = NULL,
+            updated_at = NOW()
+        WHERE id = $1 AND `+recycleBinCondition+` AND purge_after > NOW()
+    `, instance.ID, "Restored from recycle bin")
+	if err != nil {
+		// 삭제 후 같은 이름으로 새로 만든 경우
+		if isUniqueViolation(err, "unique_user_live_instance_name") {
+			return errors.NewInstanceNameConflictError(instance.Name)
+		}
+		return fmt.Errorf("undelete instance: %w", err)
+	}
+
+	return checkRowsAffected(result, "instance", instance.ExternalID)
+}
+
+func (s *DBInstanceStore) MarkPurged(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances SET
+            status = $2,
+            deleted_at = COALESCE(deleted_at, NOW()),
+            purged_at = NOW(),
+            updated_at = NOW()
+        WHERE id = $1 AND purged_at IS NULL
+    `, id, dbservice.StatusDeleting)
+	if err != nil {
+		return fmt.Errorf("mark instance purged: %w", err)
+	}
+	return nil
+}
+
 func (s *DBInstanceStore) CreateBackup(ctx context.Context, backup *dbservice.BackupRecord) error {
 	query := `
         INSERT INTO db_instance_backups (
@@ -385,6 +704,325 @@ func (s *DBInstanceStore) UpdateBackupStatus(ctx context.Context, backupID strin
 	return checkRowsAffected(result, "backup", backupID)
 }
 
+func (s *DBInstanceStore) CreateCredentialRotation(ctx context.Context, rotation *dbservice.CredentialRotation) error {
+	query := `
+        INSERT INTO db_credential_rotations (instance_id, external_id, status)
+        VALUES ($1, $2, $3)
+        RETURNING requested_at
+    `
+
+	err := s.db.QueryRowContext(ctx, query,
+		rotation.InstanceID,
+		rotation.ID,
+		rotation.Status,
+	).Scan(&rotation.RequestedAt)
+	if err != nil {
+		return fmt.Errorf("create credential rotation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DBInstanceStore) CompleteCredentialRotation(ctx context.Context, rotationID string, rotatedAt *time.Time) error {
+	// 실패한 교체는 rotatedAt 이 갱신되지 않으므로 요청 시각보다 이전(또는 NULL)
+	// CRD 시각은 초 단위라 requested_at 도 초 단위로 비교
+	query := `
+        UPDATE db_credential_rotations SET
+            status = CASE WHEN $2::timestamptz >= date_trunc('second', requested_at) THEN 'applied' ELSE 'failed' END,
+            completed_at = NOW()
+        WHERE external_id = $1 AND status = 'pending'
+    `
+
+	_, err := s.db.ExecContext(ctx, query, rotationID, toNullTime(rotatedAt))
+	if err != nil {
+		return fmt.Errorf("complete credential rotation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DBInstanceStore) RecordBackupDownload(ctx context.Context, audit *dbservice.BackupDownloadAudit) error {
+	query := `
+        INSERT INTO db_backup_downloads (
+            backup_id, user_id, action, client_ip, user_agent
+        ) VALUES (
+            $1, $2, $3, $4, $5
+        ) RETURNING created_at
+    `
+
+	err := s.db.QueryRowContext(ctx, query,
+		audit.BackupID,
+		audit.UserID,
+		audit.Action,
+		toNullString(audit.ClientIP),
+		toNullString(audit.UserAgent),
+	).Scan(&audit.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("record backup download: %w", err)
+	}
+
+	return nil
+}
+
+const selectImportsQuery = `
+        SELECT
+            instance_id, external_id, format, database_name, collection,
+            drop_existing, status, size_bytes, staging_token, error_message,
+            created_at, completed_at, sample_dataset
+        FROM db_instance_imports
+    `
+
+func (s *DBInstanceStore) CreateImport(ctx context.Context, imp *dbservice.DataImport) error {
+	query := `
+        INSERT INTO db_instance_imports (
+            instance_id, external_id, format, database_name, collection,
+            drop_existing, status, size_bytes, staging_token, sample_dataset
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+        ) RETURNING created_at
+    `
+
+	err := s.db.QueryRowContext(ctx, query,
+		imp.InstanceID,
+		imp.ID,
+		imp.Format,
+		toNullString(imp.Database),
+		toNullString(imp.Collection),
+		imp.Drop,
+		imp.Status,
+		imp.SizeBytes,
+		imp.StagingToken,
+		toNullString(imp.SampleDataset),
+	).Scan(&imp.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("create import: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DBInstanceStore) FindImport(ctx context.Context, importID string) (*dbservice.DataImport, error) {
+	row := s.db.QueryRowContext(ctx, selectImportsQuery+" WHERE external_id = $1", importID)
+	imp, err := scanImport(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find import: %w", err)
+	}
+
+	return imp, nil
+}
+
+func (s *DBInstanceStore) ListImports(ctx context.Context, instanceID int64) ([]*dbservice.DataImport, error) {
+	rows, err := s.db.QueryContext(ctx,
+		selectImportsQuery+" WHERE instance_id = $1 ORDER BY created_at DESC", instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("list imports: %w", err)
+	}
+	defer rows.Close()
+
+	imports := make([]*dbservice.DataImport, 0, 10)
+	for rows.Next() {
+		imp, err := scanImport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan import: %w", err)
+		}
+		imports = append(imports, imp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return imports, nil
+}
+
+func (s *DBInstanceStore) UpdateImportStatus(ctx context.Context, importID string, status dbservice.ImportStatus, errorMsg string) error {
+	query := `
+        UPDATE db_instance_imports SET
+            status = $2,
+            error_message = $3,
+            completed_at = CASE WHEN $2 IN ('completed', 'failed') THEN NOW() ELSE NULL END
+        WHERE external_id = $1 AND status NOT IN ('completed', 'failed')
+    `
+
+	_, err := s.db.ExecContext(ctx, query, importID, status, toNullString(errorMsg))
+	if err != nil {
+		return fmt.Errorf("update import status: %w", err)
+	}
+
+	return nil
+}
+
+const selectSchedulesQuery = `
+    SELECT s.db_instance_id, i.external_id, i.user_id,
+           s.timezone, s.start_cron, s.stop_cron, s.enabled,
+           s.next_action, s.next_action_at,
+           s.last_action, s.last_action_at,
+           s.last_skipped_at, s.last_skip_reason, s.last_skip_detail,
+           s.created_at, s.updated_at
+    FROM db_instance_schedules s
+    JOIN db_instances i ON i.id = s.db_instance_id
+`
+
+func scanSchedule(scanner interface{ Scan(...interface{}) error }) (*dbservice.PowerSchedule, error) {
+	var (
+		schedule                                  dbservice.PowerSchedule
+		startCron, stopCron                       sql.NullString
+		nextAction, lastAction                    sql.NullString
+		skipReason, skipDetail                    sql.NullString
+		nextActionAt, lastActionAt, lastSkippedAt sql.NullTime
+	)
+
+	err := scanner.Scan(
+		&schedule.InstanceID, &schedule.InstanceExternalID, &schedule.UserID,
+		&schedule.Timezone, &startCron, &stopCron, &schedule.Enabled,
+		&nextAction, &nextActionAt,
+		&lastAction, &lastActionAt,
+		&lastSkippedAt, &skipReason, &skipDetail,
+		&schedule.CreatedAt, &schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.StartCron = startCron.String
+	schedule.StopCron = stopCron.String
+	schedule.NextAction = dbservice.PowerAction(nextAction.String)
+	schedule.NextActionAt = timePtr(nextActionAt)
+	schedule.LastAction = dbservice.PowerAction(lastAction.String)
+	schedule.LastActionAt = timePtr(lastActionAt)
+	schedule.LastSkippedAt = timePtr(lastSkippedAt)
+	schedule.LastSkipReason = dbservice.ScheduleSkipReason(skipReason.String)
+	schedule.LastSkipDetail = skipDetail.String
+	return &schedule, nil
+}
+
+func (s *DBInstanceStore) UpsertSchedule(ctx context.Context, schedule *dbservice.PowerSchedule) error {
+	query := `
+        INSERT INTO db_instance_schedules (
+            db_instance_id, timezone, start_cron, stop_cron, enabled,
+            next_action, next_action_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (db_instance_id) DO UPDATE SET
+            timezone = EXCLUDED.timezone,
+            start_cron = EXCLUDED.start_cron,
+            stop_cron = EXCLUDED.stop_cron,
+            enabled = EXCLUDED.enabled,
+            next_action = EXCLUDED.next_action,
+            next_action_at = EXCLUDED.next_action_at,
+            updated_at = NOW()
+        RETURNING created_at, updated_at
+    `
+
+	err := s.db.QueryRowContext(ctx, query,
+		schedule.InstanceID,
+		schedule.Timezone,
+		toNullString(schedule.StartCron),
+		toNullString(schedule.StopCron),
+		schedule.Enabled,
+		toNullString(string(schedule.NextAction)),
+		toNullTime(schedule.NextActionAt),
+	).Scan(&schedule.CreatedAt, &schedule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("upsert schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DBInstanceStore) FindSchedule(ctx context.Context, instanceID int64) (*dbservice.PowerSchedule, error) {
+	row := s.db.QueryRowContext(ctx, selectSchedulesQuery+" WHERE s.db_instance_id = $1", instanceID)
+	schedule, err := scanSchedule(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *DBInstanceStore) ListSchedules(ctx context.Context, userID string) ([]*dbservice.PowerSchedule, error) {
+	return s.querySchedules(ctx,
+		selectSchedulesQuery+" WHERE i.user_id = $1::uuid AND i.deleted_at IS NULL", userID)
+}
+
+func (s *DBInstanceStore) DeleteSchedule(ctx context.Context, instanceID int64) error {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM db_instance_schedules WHERE db_instance_id = $1`, instanceID)
+	if err != nil {
+		return fmt.Errorf("delete schedule: %w", err)
+	}
+
+	return checkRowsAffected(result, "schedule", fmt.Sprintf("%d", instanceID))
+}
+
+func (s *DBInstanceStore) ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*dbservice.PowerSchedule, error) {
+	query := selectSchedulesQuery + `
+        WHERE s.enabled = TRUE AND s.next_action_at <= $1 AND i.deleted_at IS NULL
+        ORDER BY s.next_action_at
+        LIMIT $2
+    `
+	return s.querySchedules(ctx, query, now, limit)
+}
+
+func (s *DBInstanceStore) RecordScheduleRun(ctx context.Context, schedule *dbservice.PowerSchedule) error {
+	query := `
+        UPDATE db_instance_schedules SET
+            next_action = $2,
+            next_action_at = $3,
+            last_action = $4,
+            last_action_at = $5,
+            last_skipped_at = $6,
+            last_skip_reason = $7,
+            last_skip_detail = $8,
+            updated_at = NOW()
+        WHERE db_instance_id = $1
+    `
+
+	_, err := s.db.ExecContext(ctx, query,
+		schedule.InstanceID,
+		toNullString(string(schedule.NextAction)),
+		toNullTime(schedule.NextActionAt),
+		toNullString(string(schedule.LastAction)),
+		toNullTime(schedule.LastActionAt),
+		toNullTime(schedule.LastSkippedAt),
+		toNullString(string(schedule.LastSkipReason)),
+		toNullString(schedule.LastSkipDetail),
+	)
+	if err != nil {
+		return fmt.Errorf("record schedule run: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DBInstanceStore) querySchedules(ctx context.Context, query string, args ...interface{}) ([]*dbservice.PowerSchedule, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []*dbservice.PowerSchedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return schedules, nil
+}
+
 func (s *DBInstanceStore) queryInstances(ctx context.Context, query string, args ...interface{}) ([]*dbservice.DBInstance, error) {
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -457,3 +1095,108 @@ func (s *DBInstanceStore) ListByStatus(ctx context.Context, status dbservice.Ins
 	query := selectInstancesQuery + " WHERE status = $1 AND deleted_at IS NULL ORDER BY created_at DESC"
 	return s.queryInstances(ctx, query, status)
 }
+
+// instanceSortColumns 정렬 기준별 컬럼, 요청 값을 그대로 SQL 에 넣지 않음
+var instanceSortColumns = map[dbservice.InstanceSortField]string{
+	dbservice.SortByCreatedAt: "created_at",
+	dbservice.SortByUpdatedAt: "updated_at",
+	dbservice.SortByName:      "name",
+	dbservice.SortByStatus:    "status",
+	dbservice.SortByType:      "type",
+	dbservice.SortByCost:      "hourly_cost",
+}
+
+func (s *DBInstanceStore) Search(ctx context.Context, userID string, req *dbservice.ListInstancesRequest) ([]*dbservice.DBInstance, int, error) {
+	condition := " WHERE user_id = $1 AND deleted_at IS NULL"
+	if req.Deleted {
+		condition = " WHERE user_id = $1 AND " + recycleBinCondition
+	}
+
+	wb := &whereBuilder{argIndex: 1}
+	for _, r := range req.Selector {
+		switch r.Operator {
+		case dbservice.LabelEquals:
+			wb.add("labels @> $%d::jsonb", labelJSON(r.Key, r.Value))
+		case dbservice.LabelNotEquals:
+			wb.add("NOT (labels @> $%d::jsonb)", labelJSON(r.Key, r.Value))
+		case dbservice.LabelExists:
+			wb.add("labels ? $%d", r.Key)
+		case dbservice.LabelDoesNotExist:
+			wb.add("NOT (labels ? $%d)", r.Key)
+		}
+	}
+	if len(req.Statuses) > 0 {
+		statuses := make([]string, len(req.Statuses))
+		for i, status := range req.Statuses {
+			statuses[i] = string(status)
+		}
+		wb.add("status::text = ANY($%d)", pq.Array(statuses))
+	}
+	if len(req.Types) > 0 {
+		types := make([]string, len(req.Types))
+		for i, t := range req.Types {
+			types[i] = string(t)
+		}
+		wb.add("type::text = ANY($%d)", pq.Array(types))
+	}
+
+	where := condition + wb.build()
+	args := append([]interface{}{userID}, wb.args...)
+
+	var total int
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM db_instances"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count instances: %w", err)
+	}
+
+	column, ok := instanceSortColumns[req.Sort]
+	if !ok {
+		column = "created_at"
+	}
+	direction := "ASC"
+	if req.Desc {
+		direction = "DESC"
+	}
+
+	query := selectInstancesQuery + where + fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	query, args = addPagination(query, args, req.Limit, req.GetOffset())
+
+	instances, err := s.queryInstances(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return instances, total, nil
+}
+
+// UpdateMetadata 라벨/설명 변경, 휴지통에 있는 인스턴스는 제외
+func (s *DBInstanceStore) UpdateMetadata(ctx context.Context, id int64, labels map[string]string, description string) error {
+	labelsJSON, err := marshalLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_instances
+        SET labels = $2, description = $3, updated_at = NOW()
+        WHERE id = $1 AND deleted_at IS NULL
+    `, id, labelsJSON, toNullString(description))
+	if err != nil {
+		return fmt.Errorf("update instance metadata: %w", err)
+	}
+	return checkRowsAffected(result, "instance", fmt.Sprintf("%d", id))
+}
+
+func marshalLabels(labels map[string]string) ([]byte, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsJSON, err := json.Marshal(labels)
+	if err != nil {
+		return nil, fmt.Errorf("marshal labels: %w", err)
+	}
+	return labelsJSON, nil
+}
+
+func labelJSON(key, value string) string {
+	b, _ := json.Marshal(map[string]string{key: value})
+	return string(b)
+}
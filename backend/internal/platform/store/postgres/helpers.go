@@ -43,6 +43,13 @@ func toNullInt32(i int) sql.NullInt32 {
 	}
 }
 
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
 func timePtr(nt sql.NullTime) *time.Time {
 	if nt.Valid {
 		return &nt.Time
@@ -57,6 +64,7 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		createdFromPreset   sql.NullString
 		k8sNamespace        sql.NullString
 		k8sResourceName     sql.NullString
+		k8sSecretRef        sql.NullString
 		endpoint            sql.NullString
 		port                sql.NullInt32
 		configJSON          []byte
@@ -65,6 +73,17 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		lastBilledAt        sql.NullTime
 		pausedAt            sql.NullTime
 		deletedAt           sql.NullTime
+		conditionsJSON      []byte
+		metricsJSON         []byte
+		k8sSyncedAt         sql.NullTime
+		allowedCIDRsJSON    []byte
+		profilingJSON       []byte
+		idlePolicyJSON      []byte
+		idleSince           sql.NullTime
+		purgeAfter          sql.NullTime
+		purgedAt            sql.NullTime
+		labelsJSON          []byte
+		description         sql.NullString
 	)
 
 	err := scanner.Scan(
@@ -85,6 +104,7 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		&statusReason,
 		&k8sNamespace,
 		&k8sResourceName,
+		&k8sSecretRef,
 		&endpoint,
 		&port,
 		&configJSON,
@@ -96,6 +116,17 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		&lastBilledAt,
 		&pausedAt,
 		&deletedAt,
+		&conditionsJSON,
+		&metricsJSON,
+		&k8sSyncedAt,
+		&allowedCIDRsJSON,
+		&profilingJSON,
+		&idlePolicyJSON,
+		&idleSince,
+		&purgeAfter,
+		&purgedAt,
+		&labelsJSON,
+		&description,
 	)
 
 	if err != nil {
@@ -107,8 +138,10 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		instance.CreatedFromPreset = &createdFromPreset.String
 	}
 	instance.StatusReason = statusReason.String
+	instance.Description = description.String
 	instance.K8sNamespace = k8sNamespace.String
 	instance.K8sResourceName = k8sResourceName.String
+	instance.K8sSecretRef = k8sSecretRef.String
 	instance.Endpoint = endpoint.String
 	instance.Port = int(port.Int32)
 	instance.BackupConfig.Schedule = backupSchedule.String
@@ -132,6 +165,45 @@ func scanInstance(scanner interface{ Scan(...interface{}) error }) (*dbservice.D
 		instance.Config = make(map[string]interface{})
 	}
 
+	if len(conditionsJSON) > 0 {
+		if err := json.Unmarshal(conditionsJSON, &instance.Conditions); err != nil {
+			return nil, fmt.Errorf("unmarshal k8s conditions: %w", err)
+		}
+	}
+	if len(metricsJSON) > 0 {
+		instance.Metrics = &dbservice.InstanceMetrics{}
+		if err := json.Unmarshal(metricsJSON, instance.Metrics); err != nil {
+			return nil, fmt.Errorf("unmarshal k8s metrics: %w", err)
+		}
+	}
+	instance.K8sSyncedAt = timePtr(k8sSyncedAt)
+
+	if len(allowedCIDRsJSON) > 0 {
+		if err := json.Unmarshal(allowedCIDRsJSON, &instance.AllowedCIDRs); err != nil {
+			return nil, fmt.Errorf("unmarshal allowed cidrs: %w", err)
+		}
+	}
+	if len(profilingJSON) > 0 {
+		instance.Profiling = &dbservice.ProfilingConfig{}
+		if err := json.Unmarshal(profilingJSON, instance.Profiling); err != nil {
+			return nil, fmt.Errorf("unmarshal profiling: %w", err)
+		}
+	}
+	if len(idlePolicyJSON) > 0 {
+		instance.IdlePolicy = &dbservice.IdlePolicy{}
+		if err := json.Unmarshal(idlePolicyJSON, instance.IdlePolicy); err != nil {
+			return nil, fmt.Errorf("unmarshal idle policy: %w", err)
+		}
+	}
+	if len(labelsJSON) > 0 {
+		if err := json.Unmarshal(labelsJSON, &instance.Labels); err != nil {
+			return nil, fmt.Errorf("unmarshal labels: %w", err)
+		}
+	}
+	instance.IdleSince = timePtr(idleSince)
+	instance.PurgeAfter = timePtr(purgeAfter)
+	instance.PurgedAt = timePtr(purgedAt)
+
 	return &instance, nil
 }
 
@@ -224,6 +296,44 @@ func scanBackup(scanner interface{ Scan(...interface{}) error }) (*dbservice.Bac
 	return &backup, nil
 }
 
+func scanImport(scanner interface{ Scan(...interface{}) error }) (*dbservice.DataImport, error) {
+	var (
+		imp          dbservice.DataImport
+		database     sql.NullString
+		collection   sql.NullString
+		errorMessage sql.NullString
+		completedAt  sql.NullTime
+		dataset      sql.NullString
+	)
+
+	err := scanner.Scan(
+		&imp.InstanceID,
+		&imp.ID,
+		&imp.Format,
+		&database,
+		&collection,
+		&imp.Drop,
+		&imp.Status,
+		&imp.SizeBytes,
+		&imp.StagingToken,
+		&errorMessage,
+		&imp.CreatedAt,
+		&completedAt,
+		&dataset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	imp.Database = database.String
+	imp.Collection = collection.String
+	imp.SampleDataset = dataset.String
+	imp.ErrorMessage = errorMessage.String
+	imp.CompletedAt = timePtr(completedAt)
+
+	return &imp, nil
+}
+
 func checkRowsAffected(result sql.Result, resourceType, resourceID string) error {
 	rows, err := result.RowsAffected()
 	if err != nil {
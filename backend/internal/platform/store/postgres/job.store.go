@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/core/job"
+)
+
+type JobStore struct {
+	db *sql.DB
+}
+
+var _ job.Store = (*JobStore)(nil)
+
+func NewJobStore(db *sql.DB) job.Store {
+	return &JobStore{db: db}
+}
+
+const selectJobColumns = `
+    id, type, user_id, resource_id, payload, status, attempts, max_attempts,
+    last_error, run_at, created_at, updated_at, completed_at
+`
+
+func scanJob(scanner interface{ Scan(...interface{}) error }) (*job.Job, error) {
+	var j job.Job
+	var resourceID, lastError sql.NullString
+	var completedAt sql.NullTime
+	var payload []byte
+
+	err := scanner.Scan(&j.ID, &j.Type, &j.UserID, &resourceID, &payload, &j.Status, &j.Attempts,
+		&j.MaxAttempts, &lastError, &j.RunAt, &j.CreatedAt, &j.UpdatedAt, &completedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	j.ResourceID = resourceID.String
+	j.LastError = lastError.String
+	j.Payload = payload
+	j.CompletedAt = timePtr(completedAt)
+	return &j, nil
+}
+
+func (s *JobStore) Create(ctx context.Context, j *job.Job) error {
+	query := `
+        INSERT INTO jobs (type, user_id, resource_id, payload, max_attempts, run_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING ` + selectJobColumns
+
+	created, err := scanJob(s.db.QueryRowContext(ctx, query,
+		j.Type, j.UserID, toNullString(j.ResourceID), []byte(j.Payload), j.MaxAttempts, j.RunAt))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	*j = *created
+	return nil
+}
+
+func (s *JobStore) Find(ctx context.Context, id string) (*job.Job, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+selectJobColumns+` FROM jobs WHERE id::text = $1`, id)
+	j, err := scanJob(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find job: %w", err)
+	}
+	return j, nil
+}
+
+func (s *JobStore) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*job.Job, error) {
+	// SKIP LOCKED: 여러 서버가 같은 큐를 처리해도 중복 실행하지 않음
+	query := `
+        UPDATE jobs SET
+            status = 'running',
+            attempts = attempts + 1,
+            locked_until = $2,
+            updated_at = NOW()
+        WHERE id IN (
+            SELECT id FROM jobs
+            WHERE (status = 'pending' AND run_at <= NOW())
+               OR (status = 'running' AND locked_until < NOW())
+            ORDER BY run_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING ` + selectJobColumns
+
+	rows, err := s.db.QueryContext(ctx, query, limit, time.Now().Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("claim jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*job.Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan job: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, rows.Err()
+}
+
+func (s *JobStore) Complete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE jobs SET
+            status = 'succeeded',
+            last_error = NULL,
+            locked_until = NULL,
+            completed_at = NOW(),
+            updated_at = NOW()
+        WHERE id::text = $1
+    `, id)
+	if err != nil {
+		return fmt.Errorf("complete job: %w", err)
+	}
+	return checkRowsAffected(result, "job", id)
+}
+
+func (s *JobStore) Retry(ctx context.Context, id string, runAt time.Time, errMsg string) error {
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE jobs SET
+            status = 'pending',
+            run_at = $2,
+            last_error = $3,
+            locked_until = NULL,
+            updated_at = NOW()
+        WHERE id::text = $1
+    `, id, runAt, toNullString(errMsg))
+	if err != nil {
+		return fmt.Errorf("retry job: %w", err)
+	}
+	return checkRowsAffected(result, "job", id)
+}
+
+func (s *JobStore) Fail(ctx context.Context, id string, status job.Status, errMsg string) error {
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE jobs SET
+            status = $2,
+            last_error = $3,
+            locked_until = NULL,
+            completed_at = NOW(),
+            updated_at = NOW()
+        WHERE id::text = $1
+    `, id, status, toNullString(errMsg))
+	if err != nil {
+		return fmt.Errorf("fail job: %w", err)
+	}
+	return checkRowsAffected(result, "job", id)
+}
@@ -518,3 +518,103 @@ func (s *LemonStore) UserTransactionsWithInstance(ctx context.Context, userID st
 
 	return transactions, nil
 }
+
+func (s *LemonStore) InstanceTransactionExists(ctx context.Context, instanceID int64, actionType lemon.ActionType) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM user_lemon_transactions
+			WHERE db_instance_id = $1 AND action_type = $2 AND status = $3
+		)
+	`
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, query, instanceID, actionType, lemon.StatusSuccessful).Scan(&exists); err != nil {
+		return false, errors.Wrap(err)
+	}
+	return exists, nil
+}
+
+func (s *LemonStore) CreateRefundFailure(ctx context.Context, failure *lemon.RefundFailure) error {
+	query := `
+		INSERT INTO lemon_refund_failures (
+			user_id, amount, reason, error_message, db_instance_id, saga_id
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		) RETURNING id, created_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		failure.UserID,
+		failure.Amount,
+		failure.Reason,
+		failure.ErrorMessage,
+		failure.InstanceID,
+		failure.SagaID,
+	).Scan(&failure.ID, &failure.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (s *LemonStore) UnresolvedRefundFailures(ctx context.Context, limit int) ([]*lemon.RefundFailure, error) {
+	query := `
+		SELECT id, user_id, amount, reason, error_message, db_instance_id, saga_id, retry_count, created_at
+		FROM lemon_refund_failures
+		WHERE resolved = FALSE
+		ORDER BY created_at
+		LIMIT $1
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer rows.Close()
+
+	var failures []*lemon.RefundFailure
+	for rows.Next() {
+		var f lemon.RefundFailure
+		var reason, errorMessage sql.NullString
+		var instanceID, sagaID sql.NullInt64
+
+		if err := rows.Scan(&f.ID, &f.UserID, &f.Amount, &reason, &errorMessage,
+			&instanceID, &sagaID, &f.RetryCount, &f.CreatedAt); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		f.Reason = reason.String
+		f.ErrorMessage = errorMessage.String
+		if instanceID.Valid {
+			f.InstanceID = &instanceID.Int64
+		}
+		if sagaID.Valid {
+			f.SagaID = &sagaID.Int64
+		}
+		failures = append(failures, &f)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	return failures, nil
+}
+
+func (s *LemonStore) ResolveRefundFailure(ctx context.Context, id int64) error {
+	query := `UPDATE lemon_refund_failures SET resolved = TRUE, resolved_at = NOW() WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (s *LemonStore) IncrementRefundRetry(ctx context.Context, id int64, errMsg string) error {
+	query := `UPDATE lemon_refund_failures SET retry_count = retry_count + 1, error_message = $2 WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id, errMsg); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
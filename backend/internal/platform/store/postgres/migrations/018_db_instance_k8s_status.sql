@@ -0,0 +1,5 @@
+-- 오퍼레이터가 보고한 DBInstance status (informer 로 동기화)
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS k8s_conditions JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS k8s_metrics    JSONB,
+    ADD COLUMN IF NOT EXISTS k8s_synced_at  TIMESTAMP WITH TIME ZONE;
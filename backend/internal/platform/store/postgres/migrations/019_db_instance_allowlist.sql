@@ -0,0 +1,3 @@
+-- 외부 접속 허용 CIDR 목록 (비어 있으면 전체 허용)
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS allowed_cidrs JSONB NOT NULL DEFAULT '[]';
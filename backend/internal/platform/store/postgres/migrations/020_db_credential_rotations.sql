@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS db_credential_rotations
+(
+    id           SERIAL PRIMARY KEY,
+    instance_id  BIGINT                   NOT NULL REFERENCES db_instances (id) ON DELETE CASCADE,
+    external_id  VARCHAR(36)              NOT NULL UNIQUE,
+    status       VARCHAR(20)              NOT NULL DEFAULT 'pending',
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_credential_rotations_instance_id
+    ON db_credential_rotations (instance_id);
@@ -0,0 +1,9 @@
+-- 느린 작업 수집 설정 (NULL 이면 비활성)
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS profiling JSONB;
+
+-- k8s_secret_ref 가 저장되지 않던 기존 인스턴스 보정 (Secret 이름은 <인스턴스>-secret)
+UPDATE db_instances
+SET k8s_secret_ref = k8s_resource_name || '-secret'
+WHERE k8s_secret_ref IS NULL
+  AND k8s_resource_name IS NOT NULL;
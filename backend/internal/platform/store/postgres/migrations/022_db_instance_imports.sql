@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS db_instance_imports
+(
+    id            SERIAL PRIMARY KEY,
+    instance_id   BIGINT                   NOT NULL REFERENCES db_instances (id) ON DELETE CASCADE,
+    external_id   VARCHAR(36)              NOT NULL UNIQUE,
+    format        VARCHAR(20)              NOT NULL,
+    database_name VARCHAR(64),
+    collection    VARCHAR(255),
+    drop_existing BOOLEAN                  NOT NULL DEFAULT FALSE,
+    status        VARCHAR(20)              NOT NULL DEFAULT 'pending',
+    size_bytes    BIGINT                   NOT NULL DEFAULT 0,
+    staging_token VARCHAR(64)              NOT NULL,
+    error_message TEXT,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at  TIMESTAMP WITH TIME ZONE
+);
+
+DROP TRIGGER IF EXISTS update_imports_timestamp ON db_instance_imports;
+CREATE TRIGGER update_imports_timestamp
+    BEFORE UPDATE
+    ON db_instance_imports
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamp_column();
+
+CREATE INDEX IF NOT EXISTS idx_db_instance_imports_instance_id
+    ON db_instance_imports (instance_id, created_at DESC);
@@ -0,0 +1,12 @@
+-- 프리셋 기본 샘플 데이터셋 (NULL 이면 빈 인스턴스)
+ALTER TABLE db_presets
+    ADD COLUMN IF NOT EXISTS sample_dataset VARCHAR(64);
+
+UPDATE db_presets
+SET sample_dataset = 'movies'
+WHERE id = 'mongodb-standalone-tiny'
+  AND sample_dataset IS NULL;
+
+-- 샘플 데이터셋 적재도 import 이력으로 남김
+ALTER TABLE db_instance_imports
+    ADD COLUMN IF NOT EXISTS sample_dataset VARCHAR(64);
@@ -0,0 +1,17 @@
+-- 백업 다운로드 감사 기록 (링크 발급, 실제 다운로드)
+CREATE TABLE IF NOT EXISTS db_backup_downloads
+(
+    id         BIGSERIAL PRIMARY KEY,
+    backup_id  BIGINT                   NOT NULL REFERENCES db_instance_backups (id) ON DELETE CASCADE,
+    user_id    UUID                     NOT NULL REFERENCES users (id),
+    action     VARCHAR(20)              NOT NULL,
+    client_ip  VARCHAR(64),
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_db_backup_downloads_backup_id
+    ON db_backup_downloads (backup_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_db_backup_downloads_user_id
+    ON db_backup_downloads (user_id, created_at DESC);
@@ -0,0 +1,16 @@
+-- 스펙 변경(리사이즈)에 따른 시간당 비용 변경 이력
+-- 과금은 db_instances.hourly_cost 를 그대로 쓰고, 이 테이블은 정산/문의 대응용 기록
+CREATE TABLE IF NOT EXISTS db_instance_price_changes
+(
+    id                   BIGSERIAL PRIMARY KEY,
+    db_instance_id       BIGINT                   NOT NULL REFERENCES db_instances (id) ON DELETE CASCADE,
+    previous_size        db_size                  NOT NULL,
+    new_size             db_size                  NOT NULL,
+    previous_hourly_cost INTEGER                  NOT NULL CHECK (previous_hourly_cost >= 0),
+    new_hourly_cost      INTEGER                  NOT NULL CHECK (new_hourly_cost >= 0),
+    effective_from       TIMESTAMP WITH TIME ZONE NOT NULL, -- 새 비용이 적용되는 과금 주기 시작
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_db_instance_price_changes_instance
+    ON db_instance_price_changes (db_instance_id, created_at DESC);
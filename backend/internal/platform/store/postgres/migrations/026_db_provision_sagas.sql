@@ -0,0 +1,41 @@
+-- 인스턴스 생성 사가, db_instances 와 같은 트랜잭션에서 생성
+-- 단계별 결과를 남겨 서버가 중간에 죽어도 워커가 이어서 진행하거나 역순으로 보상
+CREATE TABLE IF NOT EXISTS db_provision_sagas
+(
+    id             BIGSERIAL PRIMARY KEY,
+    db_instance_id BIGINT                   NOT NULL UNIQUE REFERENCES db_instances (id) ON DELETE CASCADE,
+    user_id        UUID                     NOT NULL,
+    status         VARCHAR(20)              NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running', 'completed', 'compensating', 'compensated', 'failed')),
+    options        JSONB                    NOT NULL DEFAULT '{}',
+    last_error     TEXT,
+    attempts       INTEGER                  NOT NULL DEFAULT 0,
+    locked_until   TIMESTAMP WITH TIME ZONE NOT NULL,     -- 처리 중인 프로세스의 점유 만료 시각
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_db_provision_sagas_pending
+    ON db_provision_sagas (locked_until)
+    WHERE status IN ('running', 'compensating');
+
+CREATE TABLE IF NOT EXISTS db_provision_saga_steps
+(
+    saga_id    BIGINT                   NOT NULL REFERENCES db_provision_sagas (id) ON DELETE CASCADE,
+    step       VARCHAR(30)              NOT NULL,
+    status     VARCHAR(20)              NOT NULL
+        CHECK (status IN ('started', 'completed', 'compensated', 'failed')),
+    error      TEXT,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (saga_id, step)
+);
+
+-- 보상 중 환불 실패 기록을 워커가 재시도할 수 있도록 대상 인스턴스/사가 연결
+ALTER TABLE lemon_refund_failures
+    ADD COLUMN IF NOT EXISTS db_instance_id BIGINT,
+    ADD COLUMN IF NOT EXISTS saga_id        BIGINT REFERENCES db_provision_sagas (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS retry_count    INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_lemon_refund_failures_unresolved
+    ON lemon_refund_failures (created_at)
+    WHERE resolved = FALSE;
@@ -0,0 +1,25 @@
+-- 비동기 작업 큐 (인스턴스 생성/삭제/재시작 등)
+-- 재시도 횟수를 넘긴 작업은 dead 로 남겨 수동 확인 (dead-letter)
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id           UUID PRIMARY KEY                  DEFAULT gen_random_uuid(),
+    type         VARCHAR(50)              NOT NULL,
+    user_id      UUID                     NOT NULL,
+    resource_id  VARCHAR(255),
+    payload      JSONB                    NOT NULL DEFAULT '{}',
+    status       VARCHAR(20)              NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'succeeded', 'failed', 'dead')),
+    attempts     INTEGER                  NOT NULL DEFAULT 0,
+    max_attempts INTEGER                  NOT NULL DEFAULT 5 CHECK (max_attempts > 0),
+    last_error   TEXT,
+    run_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP WITH TIME ZONE,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_pending ON jobs (run_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_jobs_running ON jobs (locked_until) WHERE status = 'running';
+CREATE INDEX IF NOT EXISTS idx_jobs_dead ON jobs (updated_at) WHERE status = 'dead';
+CREATE INDEX IF NOT EXISTS idx_jobs_user ON jobs (user_id, created_at DESC);
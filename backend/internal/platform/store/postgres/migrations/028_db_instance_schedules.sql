@@ -0,0 +1,24 @@
+-- 인스턴스 자동 시작/중지 일정, cron 표현식은 timezone 기준으로 해석
+-- next_action/next_action_at 은 저장 시점과 실행 후에 다시 계산해 두고 스케줄러는 이것만 조회
+CREATE TABLE IF NOT EXISTS db_instance_schedules
+(
+    db_instance_id   BIGINT PRIMARY KEY REFERENCES db_instances (id) ON DELETE CASCADE,
+    timezone         VARCHAR(64)              NOT NULL,
+    start_cron       VARCHAR(100),
+    stop_cron        VARCHAR(100),
+    enabled          BOOLEAN                  NOT NULL DEFAULT TRUE,
+    next_action      VARCHAR(10) CHECK (next_action IN ('start', 'stop')),
+    next_action_at   TIMESTAMP WITH TIME ZONE,
+    last_action      VARCHAR(10) CHECK (last_action IN ('start', 'stop')),
+    last_action_at   TIMESTAMP WITH TIME ZONE,
+    last_skipped_at  TIMESTAMP WITH TIME ZONE,
+    last_skip_reason VARCHAR(30),
+    last_skip_detail TEXT,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (start_cron IS NOT NULL OR stop_cron IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_db_instance_schedules_due
+    ON db_instance_schedules (next_action_at)
+    WHERE enabled = TRUE AND next_action_at IS NOT NULL;
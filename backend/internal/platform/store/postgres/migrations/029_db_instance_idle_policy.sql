@@ -0,0 +1,9 @@
+-- 사용하지 않는 인스턴스 자동 중지 정책
+-- idle_since: 연결 수/초당 작업 수가 0 으로 처음 관측된 시각, 다시 사용되거나 시작하면 NULL
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS idle_policy JSONB,
+    ADD COLUMN IF NOT EXISTS idle_since  TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_db_instances_idle_policy
+    ON db_instances (id)
+    WHERE idle_policy IS NOT NULL AND deleted_at IS NULL;
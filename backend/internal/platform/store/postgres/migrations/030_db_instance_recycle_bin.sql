@@ -0,0 +1,9 @@
+-- 휴지통: 삭제하면 StatefulSet 을 0 으로 줄이고 PVC 는 purge_after 까지 보관
+-- purge_after 가 있는 삭제 인스턴스만 휴지통에 표시, 완전히 정리되면 purged_at 기록
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS purge_after TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS purged_at   TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_db_instances_recycle_bin
+    ON db_instances (purge_after)
+    WHERE deleted_at IS NOT NULL AND purged_at IS NULL AND purge_after IS NOT NULL;
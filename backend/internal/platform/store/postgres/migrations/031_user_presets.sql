@@ -0,0 +1,25 @@
+-- 사용자 프리셋: 인스턴스의 타입/모드/리소스/설정/백업 정책을 저장해 재사용
+-- owner_id 가 NULL 이면 시스템 프리셋 (014 에서 입력)
+-- visibility: system, private(본인만), pending(공개 심사 중), public(갤러리 공개), rejected
+ALTER TABLE db_presets
+    ADD COLUMN IF NOT EXISTS owner_id              UUID REFERENCES users (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS visibility            VARCHAR(20) NOT NULL DEFAULT 'system',
+    ADD COLUMN IF NOT EXISTS moderation_note       TEXT,
+    ADD COLUMN IF NOT EXISTS backup_enabled        BOOLEAN     NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS backup_schedule       VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS backup_retention_days INTEGER     NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS updated_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+ALTER TABLE db_presets
+    ADD CONSTRAINT chk_db_presets_visibility
+        CHECK (visibility IN ('system', 'private', 'pending', 'public', 'rejected')),
+    ADD CONSTRAINT chk_db_presets_owner
+        CHECK ((owner_id IS NULL) = (visibility = 'system'));
+
+CREATE INDEX IF NOT EXISTS idx_db_presets_owner
+    ON db_presets (owner_id, created_at)
+    WHERE owner_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_db_presets_visibility
+    ON db_presets (visibility)
+    WHERE visibility IN ('pending', 'public');
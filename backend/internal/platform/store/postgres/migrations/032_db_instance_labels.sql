@@ -0,0 +1,8 @@
+-- 인스턴스 라벨(key=value)과 설명, 라벨은 DBInstance CRD 라벨에도 반영
+-- 목록 조회의 라벨 셀렉터는 labels @> / ? 연산으로 처리
+ALTER TABLE db_instances
+    ADD COLUMN IF NOT EXISTS labels      JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN IF NOT EXISTS description TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_db_instances_labels
+    ON db_instances USING GIN (labels);
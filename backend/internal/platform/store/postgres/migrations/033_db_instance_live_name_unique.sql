@@ -0,0 +1,21 @@
+-- unique_user_instance_name 은 deleted_at 이 NULL 인 행끼리 구분하지 못하므로
+-- 삭제되지 않은 인스턴스 이름은 부분 인덱스로 유일하게 유지
+
+-- 이미 중복된 이름은 가장 먼저 만든 인스턴스만 남기고 external_id 를 붙여 구분
+-- (이름 최대 63자: 26 + '-' + UUID 36)
+WITH duplicates AS (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY user_id, name ORDER BY created_at, id) AS rn
+    FROM db_instances
+    WHERE deleted_at IS NULL
+)
+UPDATE db_instances d
+SET name       = LEFT(d.name, 26) || '-' || d.external_id::text,
+    updated_at = NOW()
+FROM duplicates
+WHERE d.id = duplicates.id
+  AND duplicates.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_user_live_instance_name
+    ON db_instances (user_id, name)
+    WHERE deleted_at IS NULL;
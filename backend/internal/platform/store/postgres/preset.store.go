@@ -17,6 +17,13 @@ type PresetStore struct {
 
 var _ dbservice.PresetStore = (*PresetStore)(nil)
 
+const presetColumns = `
+            id, type, size, mode, name, icon, description, friendly_description,
+            technical_terms, use_cases, cpu, memory, disk, creation_cost, hourly_cost,
+            default_config, sample_dataset, sort_order, available, unavailable_reason,
+            owner_id, visibility, moderation_note, backup_enabled, backup_schedule,
+            backup_retention_days, created_at`
+
 func NewPresetStore(db *sql.DB) dbservice.PresetStore {
 	return &PresetStore{
 		db: db,
@@ -25,10 +32,7 @@ func NewPresetStore(db *sql.DB) dbservice.PresetStore {
 
 func (s *PresetStore) Find(ctx context.Context, id string) (*dbservice.DBPreset, error) {
 	const query = `
-        SELECT 
-            id, type, size, mode, name, icon, description, friendly_description,
-            technical_terms, use_cases, cpu, memory, disk, creation_cost, hourly_cost,
-            default_config, sort_order, available, unavailable_reason
+        SELECT ` + presetColumns + `
         FROM db_presets
         WHERE id = $1
     `
@@ -47,12 +51,9 @@ func (s *PresetStore) Find(ctx context.Context, id string) (*dbservice.DBPreset,
 
 func (s *PresetStore) ListByType(ctx context.Context, dbType dbservice.DBType) ([]*dbservice.DBPreset, error) {
 	const query = `
-        SELECT 
-            id, type, size, mode, name, icon, description, friendly_description,
-            technical_terms, use_cases, cpu, memory, disk, creation_cost, hourly_cost,
-            default_config, sort_order, available, unavailable_reason
+        SELECT ` + presetColumns + `
         FROM db_presets 
-        WHERE type = $1
+        WHERE type = $1 AND visibility IN ('system', 'public')
         ORDER BY sort_order, id
     `
 
@@ -79,6 +80,139 @@ func (s *PresetStore) ListByType(ctx context.Context, dbType dbservice.DBType) (
 	return presets, nil
 }
 
+func (s *PresetStore) Create(ctx context.Context, preset *dbservice.DBPreset) error {
+	defaultConfigJSON, err := json.Marshal(preset.DefaultConfig)
+	if err != nil {
+		return fmt.Errorf("marshal default config: %w", err)
+	}
+
+	const query = `
+        INSERT INTO db_presets (
+            id, type, size, mode, name, icon, description, friendly_description,
+            use_cases, cpu, memory, disk, creation_cost, hourly_cost,
+            default_config, sort_order, available,
+            owner_id, visibility, backup_enabled, backup_schedule, backup_retention_days
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, '', $8, $9, $10, $11, $12, $13, $14, $15, true,
+                  $16, $17, $18, $19, $20)
+        RETURNING created_at
+    `
+
+	err = s.db.QueryRowContext(ctx, query,
+		preset.ID, preset.Type, preset.Size, preset.Mode,
+		preset.Name, preset.Icon, preset.Description,
+		pq.Array(preset.UseCases), preset.Resources.CPU, preset.Resources.Memory, preset.Resources.Disk,
+		preset.Cost.CreationCost, preset.Cost.HourlyLemons,
+		defaultConfigJSON, preset.SortOrder,
+		preset.OwnerID, preset.Visibility,
+		preset.Backup.Enabled, toNullString(preset.Backup.Schedule), preset.Backup.RetentionDays,
+	).Scan(&preset.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("create preset: %w", err)
+	}
+
+	preset.Available = true
+	return nil
+}
+
+func (s *PresetStore) ListByOwner(ctx context.Context, ownerID string) ([]*dbservice.DBPreset, error) {
+	const query = `
+        SELECT ` + presetColumns + `
+        FROM db_presets
+        WHERE owner_id = $1
+        ORDER BY created_at DESC
+    `
+	return s.queryPresets(ctx, query, ownerID)
+}
+
+func (s *PresetStore) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM db_presets WHERE owner_id = $1`, ownerID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count presets: %w", err)
+	}
+	return count, nil
+}
+
+func (s *PresetStore) ListPending(ctx context.Context) ([]*dbservice.DBPreset, error) {
+	const query = `
+        SELECT ` + presetColumns + `
+        FROM db_presets
+        WHERE visibility = 'pending'
+        ORDER BY updated_at
+    `
+	return s.queryPresets(ctx, query)
+}
+
+// UpdateVisibility 현재 공개 상태가 from 중 하나일 때만 변경, 그 사이 다른 요청이 바꿨으면 상태 전이 에러
+func (s *PresetStore) UpdateVisibility(ctx context.Context, id string, from []dbservice.PresetVisibility,
+	visibility dbservice.PresetVisibility, note string) error {
+	const query = `
+        UPDATE db_presets
+        SET visibility = $2, moderation_note = $3, updated_at = NOW()
+        WHERE id = $1 AND owner_id IS NOT NULL AND visibility = ANY($4)
+    `
+
+	expected := make([]string, len(from))
+	for i, v := range from {
+		expected[i] = string(v)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, id, visibility, toNullString(note), pq.Array(expected))
+	if err != nil {
+		return fmt.Errorf("update preset visibility: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rows == 0 {
+		current, err := s.Find(ctx, id)
+		if err != nil {
+			return err
+		}
+		if current == nil || !current.IsUserPreset() {
+			return errors.NewResourceNotFoundError("preset", id)
+		}
+		return errors.NewInvalidStatusTransitionError(string(current.Visibility), string(visibility))
+	}
+	return nil
+}
+
+// Delete 본인 프리셋만 삭제, 이 프리셋으로 만든 인스턴스는 created_from_preset 값만 남음
+func (s *PresetStore) Delete(ctx context.Context, id, ownerID string) error {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM db_presets WHERE id = $1 AND owner_id = $2`, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("delete preset: %w", err)
+	}
+	return checkRowsAffected(result, "preset", id)
+}
+
+func (s *PresetStore) queryPresets(ctx context.Context, query string, args ...interface{}) ([]*dbservice.DBPreset, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list presets: %w", err)
+	}
+	defer rows.Close()
+
+	var presets []*dbservice.DBPreset
+	for rows.Next() {
+		preset, err := s.scanPreset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan preset: %w", err)
+		}
+		presets = append(presets, preset)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return presets, nil
+}
+
 func (s *PresetStore) scanPreset(scanner interface{ Scan(...interface{}) error }) (*dbservice.DBPreset, error) {
 	var (
 		preset             dbservice.DBPreset
@@ -87,6 +221,10 @@ func (s *PresetStore) scanPreset(scanner interface{ Scan(...interface{}) error }
 		useCasesArr        pq.StringArray
 		cpu                float64
 		unavailableReason  sql.NullString
+		sampleDataset      sql.NullString
+		ownerID            sql.NullString
+		moderationNote     sql.NullString
+		backupSchedule     sql.NullString
 	)
 
 	err := scanner.Scan(
@@ -106,9 +244,17 @@ func (s *PresetStore) scanPreset(scanner interface{ Scan(...interface{}) error }
 		&preset.Cost.CreationCost,
 		&preset.Cost.HourlyLemons,
 		&defaultConfigJSON,
+		&sampleDataset,
 		&preset.SortOrder,
 		&preset.Available,
 		&unavailableReason,
+		&ownerID,
+		&preset.Visibility,
+		&moderationNote,
+		&preset.Backup.Enabled,
+		&backupSchedule,
+		&preset.Backup.RetentionDays,
+		&preset.CreatedAt,
 	)
 	if err != nil {
 		return nil, err
@@ -116,6 +262,10 @@ func (s *PresetStore) scanPreset(scanner interface{ Scan(...interface{}) error }
 
 	preset.Resources.CPU = cpu
 	preset.UnavailableReason = unavailableReason.String
+	preset.SampleDataset = sampleDataset.String
+	preset.OwnerID = ownerID.String
+	preset.ModerationNote = moderationNote.String
+	preset.Backup.Schedule = backupSchedule.String
 
 	// JSONB 필드 파싱
 	if err := s.parseJSONFields(&preset, technicalTermsJSON, defaultConfigJSON); err != nil {
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/errors"
+)
+
+type ProvisionSagaStore struct {
+	db *sql.DB
+}
+
+var _ dbservice.ProvisionSagaStore = (*ProvisionSagaStore)(nil)
+
+func NewProvisionSagaStore(db *sql.DB) dbservice.ProvisionSagaStore {
+	return &ProvisionSagaStore{db: db}
+}
+
+func (s *ProvisionSagaStore) CreateWithInstance(ctx context.Context, instance *dbservice.DBInstance, saga *dbservice.ProvisionSaga) error {
+	optionsJSON, err := json.Marshal(saga.Options)
+	if err != nil {
+		return fmt.Errorf("marshal saga options: %w", err)
+	}
+
+	return withTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		if err := insertInstance(ctx, tx, instance); err != nil {
+			return err
+		}
+
+		query := `
+            INSERT INTO db_provision_sagas (
+                db_instance_id, user_id, status, options, locked_until
+            ) VALUES ($1, $2, $3, $4, $5)
+            RETURNING id, created_at, updated_at
+        `
+		err := tx.QueryRowContext(ctx, query,
+			instance.ID,
+			instance.UserID,
+			saga.Status,
+			optionsJSON,
+			time.Now().Add(dbservice.ProvisionSagaLease),
+		).Scan(&saga.ID, &saga.CreatedAt, &saga.UpdatedAt)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+
+		saga.InstanceID = instance.ID
+		saga.UserID = instance.UserID
+		if saga.Steps == nil {
+			saga.Steps = make(map[dbservice.ProvisionStep]dbservice.ProvisionStepStatus)
+		}
+		return nil
+	})
+}
+
+func (s *ProvisionSagaStore) SetStep(ctx context.Context, sagaID int64, step dbservice.ProvisionStep,
+	status dbservice.ProvisionStepStatus, errMsg string) error {
+	return withTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+            INSERT INTO db_provision_saga_steps (saga_id, step, status, error)
+            VALUES ($1, $2, $3, $4)
+            ON CONFLICT (saga_id, step) DO UPDATE SET
+                status = EXCLUDED.status,
+                error = EXCLUDED.error,
+                updated_at = NOW()
+        `, sagaID, step, status, toNullString(errMsg))
+		if err != nil {
+			return errors.Wrap(err)
+		}
+
+		// 단계가 진행되는 동안은 워커가 가져가지 않도록 점유 연장
+		_, err = tx.ExecContext(ctx, `
+            UPDATE db_provision_sagas SET locked_until = $2, updated_at = NOW()
+            WHERE id = $1
+        `, sagaID, time.Now().Add(dbservice.ProvisionSagaLease))
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		return nil
+	})
+}
+
+func (s *ProvisionSagaStore) Update(ctx context.Context, saga *dbservice.ProvisionSaga) error {
+	optionsJSON, err := json.Marshal(saga.Options)
+	if err != nil {
+		return fmt.Errorf("marshal saga options: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+        UPDATE db_provision_sagas SET
+            status = $2,
+            options = $3,
+            last_error = $4,
+            locked_until = $5,
+            updated_at = NOW()
+        WHERE id = $1
+    `, saga.ID, saga.Status, optionsJSON, toNullString(saga.LastError),
+		time.Now().Add(dbservice.ProvisionSagaLease))
+	if err != nil {
+		return fmt.Errorf("update provision saga: %w", err)
+	}
+
+	return checkRowsAffected(result, "provision saga", fmt.Sprint(saga.ID))
+}
+
+const provisionSagaColumns = `id, db_instance_id, user_id, status, options, last_error, attempts, created_at, updated_at`
+
+func (s *ProvisionSagaStore) ClaimStale(ctx context.Context, limit int) ([]*dbservice.ProvisionSaga, error) {
+	// SKIP LOCKED: 여러 서버가 동시에 워커를 돌려도 같은 사가를 중복 처리하지 않음
+	query := `
+        UPDATE db_provision_sagas SET
+            locked_until = $2,
+            attempts = attempts + 1,
+            updated_at = NOW()
+        WHERE id IN (
+            SELECT id FROM db_provision_sagas
+            WHERE status IN ('running', 'compensating') AND locked_until < NOW()
+            ORDER BY locked_until
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING ` + provisionSagaColumns
+
+	return s.querySagas(ctx, query, limit, time.Now().Add(dbservice.ProvisionSagaLease))
+}
+
+func (s *ProvisionSagaStore) Claim(ctx context.Context, sagaID int64) (*dbservice.ProvisionSaga, error) {
+	query := `
+        UPDATE db_provision_sagas SET
+            locked_until = $2,
+            attempts = attempts + 1,
+            updated_at = NOW()
+        WHERE id = $1
+          AND status IN ('running', 'compensating')
+          AND (attempts = 0 OR locked_until < NOW())
+        RETURNING ` + provisionSagaColumns
+
+	sagas, err := s.querySagas(ctx, query, sagaID, time.Now().Add(dbservice.ProvisionSagaLease))
+	if err != nil || len(sagas) == 0 {
+		return nil, err
+	}
+	return sagas[0], nil
+}
+
+func (s *ProvisionSagaStore) Find(ctx context.Context, sagaID int64) (*dbservice.ProvisionSaga, error) {
+	sagas, err := s.querySagas(ctx, `SELECT `+provisionSagaColumns+` FROM db_provision_sagas WHERE id = $1`, sagaID)
+	if err != nil || len(sagas) == 0 {
+		return nil, err
+	}
+	return sagas[0], nil
+}
+
+func (s *ProvisionSagaStore) Release(ctx context.Context, sagaID int64) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE db_provision_sagas SET locked_until = NOW(), updated_at = NOW() WHERE id = $1`, sagaID)
+	if err != nil {
+		return fmt.Errorf("release provision saga: %w", err)
+	}
+	return nil
+}
+
+// querySagas 사가와 단계 상태 조회
+func (s *ProvisionSagaStore) querySagas(ctx context.Context, query string, args ...interface{}) ([]*dbservice.ProvisionSaga, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query provision sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var sagas []*dbservice.ProvisionSaga
+	byID := make(map[int64]*dbservice.ProvisionSaga)
+	for rows.Next() {
+		var saga dbservice.ProvisionSaga
+		var optionsJSON []byte
+		var lastError sql.NullString
+		if err := rows.Scan(&saga.ID, &saga.InstanceID, &saga.UserID, &saga.Status, &optionsJSON,
+			&lastError, &saga.Attempts, &saga.CreatedAt, &saga.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan provision saga: %w", err)
+		}
+		if err := json.Unmarshal(optionsJSON, &saga.Options); err != nil {
+			return nil, fmt.Errorf("unmarshal saga options: %w", err)
+		}
+		saga.LastError = lastError.String
+		saga.Steps = make(map[dbservice.ProvisionStep]dbservice.ProvisionStepStatus)
+
+		sagas = append(sagas, &saga)
+		byID[saga.ID] = &saga
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate provision sagas: %w", err)
+	}
+	if len(sagas) == 0 {
+		return sagas, nil
+	}
+
+	ids := make([]int64, 0, len(sagas))
+	for _, saga := range sagas {
+		ids = append(ids, saga.ID)
+	}
+
+	stepRows, err := s.db.QueryContext(ctx,
+		`SELECT saga_id, step, status FROM db_provision_saga_steps WHERE saga_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("list saga steps: %w", err)
+	}
+	defer stepRows.Close()
+
+	for stepRows.Next() {
+		var sagaID int64
+		var step dbservice.ProvisionStep
+		var status dbservice.ProvisionStepStatus
+		if err := stepRows.Scan(&sagaID, &step, &status); err != nil {
+			return nil, fmt.Errorf("scan saga step: %w", err)
+		}
+		byID[sagaID].Steps[step] = status
+	}
+
+	return sagas, stepRows.Err()
+}
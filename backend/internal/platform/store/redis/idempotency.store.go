@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/piper-hyowon/dBtree/internal/platform/rest"
+	"github.com/piper-hyowon/dBtree/internal/platform/store/redis/keys"
+)
+
+type IdempotencyStore struct {
+	redis *redis.Client
+}
+
+var _ rest.IdempotencyStore = (*IdempotencyStore)(nil)
+
+func NewIdempotencyStore(client *redis.Client) rest.IdempotencyStore {
+	return &IdempotencyStore{redis: client}
+}
+
+func (s *IdempotencyStore) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*rest.IdempotencyRecord, error) {
+	data, err := json.Marshal(&rest.IdempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, fmt.Errorf("marshal idempotency record: %w", err)
+	}
+
+	redisKey := keys.IdempotencyKey(key)
+	ok, err := s.redis.SetNX(ctx, redisKey, data, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if ok {
+		return nil, nil
+	}
+
+	raw, err := s.redis.Get(ctx, redisKey).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			// 조회 직전에 만료됨, 다시 예약
+			return s.Reserve(ctx, key, fingerprint, ttl)
+		}
+		return nil, fmt.Errorf("get idempotency record: %w", err)
+	}
+
+	var record rest.IdempotencyRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, fmt.Errorf("unmarshal idempotency record: %w", err)
+	}
+	return &record, nil
+}
+
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, record *rest.IdempotencyRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal idempotency record: %w", err)
+	}
+	if err := s.redis.Set(ctx, keys.IdempotencyKey(key), data, ttl).Err(); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := s.redis.Del(ctx, keys.IdempotencyKey(key)).Err(); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
@@ -5,7 +5,8 @@ import (
 )
 
 const (
-	prefixQuiz = "quiz"
+	prefixQuiz        = "quiz"
+	prefixIdempotency = "idempotency"
 )
 
 // InProgressKey (SetNX 사용)
@@ -15,3 +16,10 @@ const (
 func InProgressKey(userEmail string) string {
 	return fmt.Sprintf("%s:in_progress:%s", prefixQuiz, userEmail)
 }
+
+// IdempotencyKey (SetNX 사용)
+// Value: JSON{ fingerprint, completed, 저장된 응답 }
+// 처리 중에는 짧은 TTL, 완료 후 24시간 보관
+func IdempotencyKey(scopedKey string) string {
+	return fmt.Sprintf("%s:%s", prefixIdempotency, scopedKey)
+}
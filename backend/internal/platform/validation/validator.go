@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/piper-hyowon/dBtree/internal/core/errors"
+	"github.com/piper-hyowon/dBtree/internal/utils/cron"
 )
 
 var (
@@ -59,69 +60,12 @@ func registerCustomValidations() {
 		// 예: "0 2 * * *" (매일 새벽 2시)
 		// 예: "*/30 * * * *" (30분마다)
 		// 예: "0 0 * * 0" (매주 일요일 자정)
-		parts := strings.Fields(schedule)
-		if len(parts) != 5 {
-			return false
-		}
-
-		// 각 필드의 유효성 검증
-		for i, part := range parts {
-			if !isValidCronField(part, i) {
-				return false
-			}
-		}
-
-		return true
+		// 실행 시각 계산과 같은 파서를 사용
+		_, err := cron.Parse(schedule)
+		return err == nil
 	})
 }
 
-// isValidCronField cron 필드 유효성 검증 (TODO: ...임시
-func isValidCronField(field string, position int) bool {
-	if field == "*" {
-		return true
-	}
-
-	// 스텝 값 (*/n)
-	if strings.HasPrefix(field, "*/") {
-		return true
-	}
-
-	// 범위 (n-m)
-	if strings.Contains(field, "-") {
-		return true
-	}
-
-	// 리스트 (n,m,o)
-	if strings.Contains(field, ",") {
-		return true
-	}
-
-	// 단일 숫자
-	switch position {
-	case 0: // 분 (0-59)
-		return isInRange(field, 0, 59)
-	case 1: // 시 (0-23)
-		return isInRange(field, 0, 23)
-	case 2: // 일 (1-31)
-		return isInRange(field, 1, 31)
-	case 3: // 월 (1-12)
-		return isInRange(field, 1, 12)
-	case 4: // 요일 (0-6, 0=일요일)
-		return isInRange(field, 0, 6)
-	}
-
-	return false
-}
-
-func isInRange(field string, min, max int) bool {
-	var num int
-	_, err := fmt.Sscanf(field, "%d", &num)
-	if err != nil {
-		return false
-	}
-	return num >= min && num <= max
-}
-
 func ValidateStruct(s interface{}) error {
 	if err := GetValidator().Struct(s); err != nil {
 		return ParseValidationError(err)
@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+	"github.com/piper-hyowon/dBtree/internal/core/email"
+	"github.com/piper-hyowon/dBtree/internal/core/user"
+)
+
+// IdleScheduler 유휴 자동 중지 정책 실행 후 소유자에게 메일 발송
+// 레몬 부족 일시정지(BillingScheduler)와 별개로 StatusStopped + IdlePausedReason 으로 중지
+type IdleScheduler struct {
+	dbsService       dbservice.Service
+	userStore        user.Store
+	emailService     email.Service
+	dashboardBaseURL string
+	logger           *log.Logger
+
+	ticker    *time.Ticker
+	done      chan bool
+	mutex     sync.Mutex
+	isRunning bool
+	interval  time.Duration
+}
+
+var _ ManualRunScheduler = (*IdleScheduler)(nil)
+
+func NewIdleScheduler(
+	dbsService dbservice.Service,
+	userStore user.Store,
+	emailService email.Service,
+	dashboardBaseURL string,
+	logger *log.Logger,
+	interval time.Duration,
+) *IdleScheduler {
+	if interval <= 0 {
+		interval = 10 * time.Minute // 메트릭 유효 시간(IdleMetricsMaxAge) 보다 짧게
+	}
+
+	return &IdleScheduler{
+		dbsService:       dbsService,
+		userStore:        userStore,
+		emailService:     emailService,
+		dashboardBaseURL: dashboardBaseURL,
+		logger:           logger,
+		interval:         interval,
+		done:             make(chan bool),
+	}
+}
+
+func (s *IdleScheduler) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.isRunning {
+		s.logger.Println("유휴 자동 중지 스케줄러가 이미 실행 중입니다")
+		return nil
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.done = make(chan bool)
+	s.isRunning = true
+
+	go s.run()
+	s.logger.Println("유휴 자동 중지 스케줄러가 시작되었습니다")
+	return nil
+}
+
+func (s *IdleScheduler) Stop() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isRunning {
+		s.logger.Println("유휴 자동 중지 스케줄러가 이미 중지됨")
+		return nil
+	}
+
+	s.ticker.Stop()
+	s.done <- true
+	s.isRunning = false
+	s.logger.Println("유휴 자동 중지 스케줄러가 중지되었습니다")
+	return nil
+}
+
+func (s *IdleScheduler) IsRunning() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isRunning
+}
+
+// RunNow 즉시 실행 (테스트/관리용)
+func (s *IdleScheduler) RunNow(ctx context.Context) error {
+	s.process()
+	return nil
+}
+
+func (s *IdleScheduler) run() {
+	s.process()
+
+	for {
+		select {
+		case <-s.ticker.C:
+			s.process()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *IdleScheduler) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	paused, err := s.dbsService.PauseIdleInstances(ctx)
+	if err != nil {
+		s.logger.Printf("유휴 인스턴스 확인 실패: %v", err)
+		return
+	}
+
+	for _, instance := range paused {
+		s.notify(ctx, instance)
+	}
+}
+
+// notify 메일 발송 실패는 중지 결과에 영향 없음
+func (s *IdleScheduler) notify(ctx context.Context, instance *dbservice.DBInstance) {
+	owner, err := s.userStore.FindById(ctx, instance.UserID)
+	if err != nil || owner == nil {
+		s.logger.Printf("인스턴스 %s 소유자 조회 실패: %v", instance.ExternalID, err)
+		return
+	}
+
+	if err := s.emailService.SendIdlePaused(ctx, owner.Email, instance.Name,
+		instance.IdlePolicy.IdleHours, s.resumeURL(instance)); err != nil {
+		s.logger.Printf("인스턴스 %s 유휴 중지 메일 발송 실패: %v", instance.ExternalID, err)
+	}
+}
+
+// resumeURL 대시보드에서 로그인 후 POST /db/instances/:id/resume 호출
+func (s *IdleScheduler) resumeURL(instance *dbservice.DBInstance) string {
+	query := url.Values{}
+	query.Set("instance", instance.ExternalID)
+	query.Set("action", "resume")
+	return fmt.Sprintf("%s/dashboard?%s", s.dashboardBaseURL, query.Encode())
+}
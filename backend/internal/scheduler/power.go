@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+const powerScheduleBatch = 100
+
+// PowerScheduler 인스턴스 자동 시작/중지 일정 실행
+type PowerScheduler struct {
+	dbsService dbservice.Service
+	logger     *log.Logger
+
+	ticker    *time.Ticker
+	done      chan bool
+	mutex     sync.Mutex
+	isRunning bool
+	interval  time.Duration
+}
+
+var _ ManualRunScheduler = (*PowerScheduler)(nil)
+
+func NewPowerScheduler(dbsService dbservice.Service, logger *log.Logger, interval time.Duration) *PowerScheduler {
+	if interval <= 0 {
+		interval = 1 * time.Minute // cron 최소 단위
+	}
+
+	return &PowerScheduler{
+		dbsService: dbsService,
+		logger:     logger,
+		interval:   interval,
+		done:       make(chan bool),
+	}
+}
+
+func (s *PowerScheduler) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.isRunning {
+		s.logger.Println("자동 시작/중지 스케줄러가 이미 실행 중입니다")
+		return nil
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.done = make(chan bool)
+	s.isRunning = true
+
+	go s.run()
+	s.logger.Println("자동 시작/중지 스케줄러가 시작되었습니다")
+	return nil
+}
+
+func (s *PowerScheduler) Stop() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isRunning {
+		s.logger.Println("자동 시작/중지 스케줄러가 이미 중지됨")
+		return nil
+	}
+
+	s.ticker.Stop()
+	s.done <- true
+	s.isRunning = false
+	s.logger.Println("자동 시작/중지 스케줄러가 중지되었습니다")
+	return nil
+}
+
+func (s *PowerScheduler) IsRunning() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isRunning
+}
+
+// RunNow 즉시 실행 (테스트/관리용)
+func (s *PowerScheduler) RunNow(ctx context.Context) error {
+	s.process()
+	return nil
+}
+
+func (s *PowerScheduler) run() {
+	// 시작할 때 한번 실행, 서버가 내려가 있던 동안 놓친 일정 처리
+	s.process()
+
+	for {
+		select {
+		case <-s.ticker.C:
+			s.process()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *PowerScheduler) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	executed, err := s.dbsService.RunDueSchedules(ctx, powerScheduleBatch)
+	if err != nil {
+		s.logger.Printf("자동 시작/중지 일정 실행 실패: %v", err)
+		return
+	}
+	if executed > 0 {
+		s.logger.Printf("자동 시작/중지 %d건 실행", executed)
+	}
+}
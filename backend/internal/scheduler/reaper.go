@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/piper-hyowon/dBtree/internal/core/dbservice"
+)
+
+// RecycleBinReaper 복구 기간이 지난 휴지통 인스턴스를 완전 삭제 (CRD 삭제 → 오퍼레이터가 PVC 정리)
+type RecycleBinReaper struct {
+	dbsService dbservice.Service
+	logger     *log.Logger
+
+	ticker    *time.Ticker
+	done      chan bool
+	mutex     sync.Mutex
+	isRunning bool
+	interval  time.Duration
+}
+
+var _ ManualRunScheduler = (*RecycleBinReaper)(nil)
+
+func NewRecycleBinReaper(dbsService dbservice.Service, logger *log.Logger, interval time.Duration) *RecycleBinReaper {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	return &RecycleBinReaper{
+		dbsService: dbsService,
+		logger:     logger,
+		interval:   interval,
+		done:       make(chan bool),
+	}
+}
+
+func (s *RecycleBinReaper) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.isRunning {
+		s.logger.Println("휴지통 정리 스케줄러가 이미 실행 중입니다")
+		return nil
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.done = make(chan bool)
+	s.isRunning = true
+
+	go s.run()
+	s.logger.Println("휴지통 정리 스케줄러가 시작되었습니다")
+	return nil
+}
+
+func (s *RecycleBinReaper) Stop() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isRunning {
+		s.logger.Println("휴지통 정리 스케줄러가 이미 중지됨")
+		return nil
+	}
+
+	s.ticker.Stop()
+	s.done <- true
+	s.isRunning = false
+	s.logger.Println("휴지통 정리 스케줄러가 중지되었습니다")
+	return nil
+}
+
+func (s *RecycleBinReaper) IsRunning() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isRunning
+}
+
+// RunNow 즉시 실행 (테스트/관리용)
+func (s *RecycleBinReaper) RunNow(ctx context.Context) error {
+	s.process()
+	return nil
+}
+
+func (s *RecycleBinReaper) run() {
+	s.process()
+
+	for {
+		select {
+		case <-s.ticker.C:
+			s.process()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *RecycleBinReaper) process() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	purged, err := s.dbsService.PurgeExpiredInstances(ctx, dbservice.PurgeBatch)
+	if err != nil {
+		s.logger.Printf("휴지통 정리 실패: %v", err)
+		return
+	}
+	if purged > 0 {
+		s.logger.Printf("휴지통 인스턴스 %d개 완전 삭제", purged)
+	}
+}
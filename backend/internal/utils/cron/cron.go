@@ -0,0 +1,201 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 표준 5필드 cron 표현식 (분 시 일 월 요일)
+// 일/요일이 둘 다 지정되면 둘 중 하나만 맞아도 실행 (vixie cron 동작)
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+type fieldRange struct {
+	name     string
+	min, max int
+}
+
+var fieldRanges = [5]fieldRange{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7}, // 0, 7 모두 일요일
+}
+
+// searchLimit 이 기간 안에 일치하는 시각이 없으면 실행되지 않는 표현식으로 봄 (예: 2월 30일)
+const searchLimit = 5
+
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fieldRanges) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(fieldRanges), len(parts))
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		b, err := parseField(part, fieldRanges[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fieldRanges[i].name, err)
+		}
+		bits[i] = b
+	}
+
+	dow := bits[4]
+	if dow&(1<<7) != 0 {
+		dow = dow&^(1<<7) | 1
+	}
+
+	return &Schedule{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    dow,
+		domAny: strings.HasPrefix(parts[2], "*"),
+		dowAny: strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseField(field string, r fieldRange) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = n
+		}
+
+		lo, hi := r.min, r.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(from, r); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(to, r); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			v, err := parseValue(rangePart, r)
+			if err != nil {
+				return 0, err
+			}
+			// "5/15" 는 5부터 끝까지 15 간격
+			lo = v
+			if !hasStep {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, r fieldRange) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < r.min || v > r.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, r.min, r.max)
+	}
+	return v, nil
+}
+
+// Next after 이후(같은 분 제외) 처음 일치하는 시각, after 의 시간대 기준
+// 일치하는 시각이 없으면 zero time
+//
+// 서머타임 전환은 vixie cron 과 같이 처리. 시가 지정된 일정이 건너뛴 시간에 걸리면
+// 전환 직후 실행하고, 반복되는 시간에서는 한 번만 실행. 매시 실행하는 일정은 그대로 따름
+func (s *Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(searchLimit, 0, 0)
+
+	for t.Before(limit) {
+		var next time.Time
+		switch {
+		case !has(s.month, int(t.Month())):
+			next = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			next = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.skippedByDST(t):
+			return t
+		case !has(s.hour, t.Hour()):
+			next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !has(s.minute, t.Minute()), s.repeatedByDST(t):
+			next = t.Add(time.Minute)
+		default:
+			return t
+		}
+
+		// 서머타임 전환 구간에서 time.Date 가 되돌아가는 경우
+		if !next.After(t) {
+			next = t.Add(time.Minute)
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+// everyHour 시 필드가 모든 시간을 포함하면 서머타임 보정을 하지 않음
+func (s *Schedule) everyHour() bool {
+	return s.hour == 1<<24-1
+}
+
+// skippedByDST t 가 봄 전환 직후 첫 분이고, 건너뛴 시간 중 일치하는 시각이 있었는지
+func (s *Schedule) skippedByDST(t time.Time) bool {
+	if s.everyHour() || t.Minute() != 0 {
+		return false
+	}
+	prev := t.Add(-time.Minute)
+	if prev.Day() != t.Day() {
+		return false
+	}
+	for h := prev.Hour() + 1; h < t.Hour(); h++ {
+		if has(s.hour, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// repeatedByDST t 가 가을 전환으로 두 번째 반복되는 시간인지, 첫 번째에서 이미 실행됨
+func (s *Schedule) repeatedByDST(t time.Time) bool {
+	if s.everyHour() {
+		return false
+	}
+	prev := t.Add(-time.Hour)
+	return prev.Day() == t.Day() && prev.Hour() == t.Hour()
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
@@ -0,0 +1,159 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func bits(values ...int) uint64 {
+	var b uint64
+	for _, v := range values {
+		b |= 1 << uint(v)
+	}
+	return b
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+		check   func(s *Schedule) bool
+	}{
+		{expr: "5/15 * * * *", check: func(s *Schedule) bool { return s.minute == bits(5, 20, 35, 50) }},
+		{expr: "0 1-5/2 * * *", check: func(s *Schedule) bool { return s.hour == bits(1, 3, 5) }},
+		{expr: "0 0 * * 1-5/2", check: func(s *Schedule) bool { return s.dow == bits(1, 3, 5) }},
+		{expr: "0 0,12 * * *", check: func(s *Schedule) bool { return s.hour == bits(0, 12) }},
+		// 7 은 일요일(0)로 합쳐짐
+		{expr: "0 0 * * 7", check: func(s *Schedule) bool { return s.dow == bits(0) }},
+		{expr: "0 0 * * 5-7", check: func(s *Schedule) bool { return s.dow == bits(0, 5, 6) }},
+		{expr: "0 0 1 * *", check: func(s *Schedule) bool { return !s.domAny && s.dowAny }},
+		{expr: "0 0 */2 * 1", check: func(s *Schedule) bool { return s.domAny && !s.dowAny }},
+
+		{expr: "* * * *", wantErr: true},
+		{expr: "* * * * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "0 24 * * *", wantErr: true},
+		{expr: "0 0 0 * *", wantErr: true},
+		{expr: "0 0 * 13 *", wantErr: true},
+		{expr: "0 0 * * 8", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "5-1 * * * *", wantErr: true},
+		{expr: "a * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = nil error, want error", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.expr, err)
+			}
+			if !tt.check(s) {
+				t.Errorf("Parse(%q) = %+v", tt.expr, *s)
+			}
+		})
+	}
+}
+
+func TestDayMatches(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		day  time.Time
+		want bool
+	}{
+		// 일/요일이 둘 다 지정되면 OR
+		{"dom or dow, both", "0 0 1 * 1", date(time.June, 1), true},
+		{"dom or dow, monday only", "0 0 1 * 1", date(time.June, 8), true},
+		{"dom or dow, first only", "0 0 1 * 1", date(time.July, 1), true},
+		{"dom or dow, neither", "0 0 1 * 1", date(time.July, 2), false},
+
+		{"dom only", "0 0 1 * *", date(time.July, 1), true},
+		{"dom only, monday", "0 0 1 * *", date(time.June, 8), false},
+		{"dow only", "0 0 * * 1", date(time.June, 8), true},
+		{"dow only, first", "0 0 * * 1", date(time.July, 1), false},
+		{"sunday as 7", "0 0 * * 7", date(time.June, 7), true},
+
+		// "*/2" 도 * 로 시작하므로 AND
+		{"star step dom, even monday", "0 0 */2 * 1", date(time.June, 8), false},
+		{"star step dom, odd monday", "0 0 */2 * 1", date(time.June, 15), true},
+		{"star step dom, odd tuesday", "0 0 */2 * 1", date(time.June, 9), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.expr, err)
+			}
+			if got := s.dayMatches(tt.day); got != tt.want {
+				t.Errorf("dayMatches(%s) = %v, want %v", tt.day.Format("2006-01-02 Mon"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata not available: %v", err)
+	}
+	utc := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+	}
+	// 2026-03-08 02:00 EST -> 03:00 EDT, 2026-11-01 02:00 EDT -> 01:00 EST
+	ny := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2026, month, day, hour, min, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"same minute excluded", "* * * * *", utc(2026, 1, 1, 10, 0).Add(30 * time.Second), utc(2026, 1, 1, 10, 1)},
+		{"step from value", "5/15 * * * *", utc(2026, 1, 1, 10, 6), utc(2026, 1, 1, 10, 20)},
+		{"step wraps hour", "5/15 * * * *", utc(2026, 1, 1, 10, 50), utc(2026, 1, 1, 11, 5)},
+		{"range with step", "0 12 * * 1-5/2", utc(2026, 1, 3, 0, 0), utc(2026, 1, 5, 12, 0)},
+		{"sunday as 7", "0 0 * * 7", utc(2026, 1, 1, 0, 0), utc(2026, 1, 4, 0, 0)},
+		{"dom or dow", "0 9 1 * 1", utc(2026, 6, 2, 0, 0), utc(2026, 6, 8, 9, 0)},
+		{"leap day", "0 0 29 2 *", utc(2026, 3, 1, 0, 0), utc(2028, 2, 29, 0, 0)},
+		{"impossible date", "0 0 30 2 *", utc(2026, 1, 1, 0, 0), time.Time{}},
+		{"impossible dom in month", "0 0 31 4 *", utc(2026, 1, 1, 0, 0), time.Time{}},
+
+		// 건너뛴 02:30 은 전환 직후 03:00 EDT 에 실행하고, 다음 날은 정상
+		{"spring forward skipped", "30 2 * * *", ny(time.March, 8, 0, 0), ny(time.March, 8, 3, 0)},
+		{"spring forward next day", "30 2 * * *", ny(time.March, 8, 3, 0), ny(time.March, 9, 2, 30)},
+		{"spring forward hourly", "30 * * * *", ny(time.March, 8, 1, 30), ny(time.March, 8, 3, 30)},
+		// 반복되는 01:30 은 EDT 에서 한 번만
+		{"fall back first", "30 1 * * *", ny(time.November, 1, 0, 0), utc(2026, 11, 1, 5, 30)},
+		{"fall back once", "30 1 * * *", utc(2026, 11, 1, 5, 30).In(newYork), utc(2026, 11, 2, 6, 30)},
+		{"fall back hourly repeats", "*/30 * * * *", utc(2026, 11, 1, 5, 45).In(newYork), utc(2026, 11, 1, 6, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.expr, err)
+			}
+			got := s.Next(tt.after)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+			if !got.IsZero() && got.Location() != tt.after.Location() {
+				t.Errorf("Next(%s) location = %s, want %s", tt.after, got.Location(), tt.after.Location())
+			}
+		})
+	}
+}
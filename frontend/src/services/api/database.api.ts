@@ -1,6 +1,7 @@
 import {apiClient, handleApiError} from './client';
 import {
     InstanceResponse,
+    InstanceListResponse,
     CreateInstanceRequest,
     PresetResponse,
     EstimateCostRequest,
@@ -12,8 +13,8 @@ import {
  */
 export const getInstances = async (): Promise<InstanceResponse[]> => {
     try {
-        const response = await apiClient.get<InstanceResponse[]>('/db/instances');
-        return response.data;
+        const response = await apiClient.get<InstanceListResponse>('/db/instances');
+        return response.data.data;
     } catch (error) {
         handleApiError(error);
         throw error;
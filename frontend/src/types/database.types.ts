@@ -1,3 +1,5 @@
+import {PaginationInfo} from '../services/api/account.api';
+
 export type DBType = 'mongodb' | 'redis';
 export type DBSize = 'small' | 'medium' | 'large';
 
@@ -43,6 +45,13 @@ export interface InstanceResponse {
     updatedAt: string;               // ISO 8601
     createdFromPreset?: string;      // 프리셋 ID
     pausedAt?: string;               // ISO 8601
+    labels: Record<string, string>;
+    description?: string;
+}
+
+export interface InstanceListResponse {
+    data: InstanceResponse[];
+    pagination: PaginationInfo;
 }
 
 export interface CreateInstanceRequest {
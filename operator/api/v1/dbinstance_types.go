@@ -203,7 +203,170 @@ type DBInstanceSpec struct {
 	// +kubebuilder:validation:Required
 	ExternalID string `json:"externalId"`
 
+	// NodePort for direct external access. 0 이면 NodePort 없이 ClusterIP 로 생성
+	// +optional
 	ExternalPort int32 `json:"externalPort,omitempty"`
+
+	// SNI hostname routed to this instance by the shared TLS gateway
+	// (<externalId>.db.<domain>). Empty disables gateway routing
+	// +optional
+	ExternalHost string `json:"externalHost,omitempty"`
+
+	// CIDRs allowed to reach the instance from outside the cluster.
+	// Empty allows every source
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	AllowedSourceRanges []string `json:"allowedSourceRanges,omitempty"`
+
+	// Slow operation capture settings
+	// +optional
+	Profiling *ProfilingSpec `json:"profiling,omitempty"`
+
+	// CredentialsRotation requests a live password rotation. When it differs
+	// from status.credentialsRotation the operator applies the secret's
+	// pending-password key to the running database without restarting pods
+	// +optional
+	CredentialsRotation string `json:"credentialsRotation,omitempty"`
+
+	// DataImport requests loading data into the running instance. A new ID
+	// starts a new import job; finished imports are reported in status.dataImport
+	// +optional
+	DataImport *DataImportSpec `json:"dataImport,omitempty"`
+
+	// CloneFrom fills the new instance with data from another instance before
+	// it is marked running. Only read while the instance is being provisioned
+	// +optional
+	CloneFrom *CloneSource `json:"cloneFrom,omitempty"`
+}
+
+// CloneSource names the instance (and optionally one of its backups) to copy from
+type CloneSource struct {
+	// Instance is the source DBInstance name in the same namespace
+	// +kubebuilder:validation:MinLength=1
+	Instance string `json:"instance"`
+
+	// Archive is a backup archive on the source's backup PVC, relative to /backup.
+	// Empty copies the live data of the source instance
+	// +optional
+	Archive string `json:"archive,omitempty"`
+}
+
+// ClonePhase is the progress of the initial data copy
+type ClonePhase string
+
+const (
+	CloneRunning   ClonePhase = "Running"
+	CloneSucceeded ClonePhase = "Succeeded"
+	CloneFailed    ClonePhase = "Failed"
+)
+
+// CloneStatus reports the initial data copy requested by spec.cloneFrom
+type CloneStatus struct {
+	Phase ClonePhase `json:"phase"`
+
+	// Message holds the tail of the job output when the copy failed
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// Finished reports whether the copy reached a terminal phase
+func (s *CloneStatus) Finished() bool {
+	return s.Phase == CloneSucceeded || s.Phase == CloneFailed
+}
+
+// DataImportFormat is the format of the file loaded by an import job
+// +kubebuilder:validation:Enum=mongodump;json;csv;resp
+type DataImportFormat string
+
+const (
+	// DataImportMongodump is a mongodump --archive file, optionally gzipped
+	DataImportMongodump DataImportFormat = "mongodump"
+	// DataImportJSON is a JSON array or newline-delimited JSON documents for one collection
+	DataImportJSON DataImportFormat = "json"
+	// DataImportCSV is a CSV file with a header line for one collection
+	DataImportCSV DataImportFormat = "csv"
+	// DataImportRESP is a Redis protocol stream replayed with redis-cli --pipe
+	DataImportRESP DataImportFormat = "resp"
+)
+
+// DataImportSpec describes a file to load into the instance. The import job
+// downloads SourceURL and replays it with the engine's client tools
+type DataImportSpec struct {
+	// ID identifies the import request (backend import record ID)
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+
+	Format DataImportFormat `json:"format"`
+
+	// SourceURL is fetched by the job (백엔드 스테이징 URL, 일회용 토큰 포함)
+	// +kubebuilder:validation:MinLength=1
+	SourceURL string `json:"sourceUrl"`
+
+	// Target database, required for json and csv
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Target collection, required for json and csv
+	// +optional
+	Collection string `json:"collection,omitempty"`
+
+	// Drop replaces existing data in the target before loading
+	// +optional
+	Drop bool `json:"drop,omitempty"`
+}
+
+// DataImportPhase is the progress of an import job
+type DataImportPhase string
+
+const (
+	DataImportRunning   DataImportPhase = "Running"
+	DataImportSucceeded DataImportPhase = "Succeeded"
+	DataImportFailed    DataImportPhase = "Failed"
+)
+
+// DataImportStatus reports the progress of the last import request
+type DataImportStatus struct {
+	// ID of the import request this status belongs to
+	ID string `json:"id"`
+
+	Phase DataImportPhase `json:"phase"`
+
+	// Message holds the tail of the job output when the import failed
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// Finished reports whether the import reached a terminal phase
+func (s *DataImportStatus) Finished() bool {
+	return s.Phase == DataImportSucceeded || s.Phase == DataImportFailed
+}
+
+// DefaultSlowOpThresholdMs is used when profiling is off or no threshold is set
+const DefaultSlowOpThresholdMs int32 = 100
+
+// ProfilingSpec configures slow operation capture. Changing it rewrites the
+// engine config, which rolls the pods
+type ProfilingSpec struct {
+	// Enabled turns on the MongoDB profiler (slowOp mode) or the Redis SLOWLOG threshold
+	Enabled bool `json:"enabled"`
+
+	// Operations slower than this are recorded
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60000
+	SlowOpThresholdMs int32 `json:"slowOpThresholdMs,omitempty"`
 }
 
 // InstanceMetrics matches backend's metrics fields
@@ -288,6 +451,63 @@ type DBInstanceStatus struct {
 	// ObservedGeneration for reconciliation optimization
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Recent lifecycle transitions, oldest first
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	History []StatusTransition `json:"history,omitempty"`
+
+	// Finish time of the last backup job whose outcome was recorded
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// Source ranges currently enforced for external access
+	// +optional
+	AppliedSourceRanges []string `json:"appliedSourceRanges,omitempty"`
+
+	// Time the current source ranges were applied
+	// +optional
+	SourceRangesAppliedAt *metav1.Time `json:"sourceRangesAppliedAt,omitempty"`
+
+	// Last credentials rotation request that was processed
+	// +optional
+	CredentialsRotation string `json:"credentialsRotation,omitempty"`
+
+	// Time the current credentials were applied
+	// +optional
+	CredentialsRotatedAt *metav1.Time `json:"credentialsRotatedAt,omitempty"`
+
+	// Progress of the last data import request
+	// +optional
+	DataImport *DataImportStatus `json:"dataImport,omitempty"`
+
+	// Progress of the initial data copy from spec.cloneFrom
+	// +optional
+	Clone *CloneStatus `json:"clone,omitempty"`
+}
+
+// MaxStatusHistory bounds the number of entries kept in status.history
+const MaxStatusHistory = 20
+
+// StatusTransition records a lifecycle transition of the instance.
+// Every entry is also emitted as a Kubernetes Event with the same reason.
+type StatusTransition struct {
+	// Time the transition happened
+	Time metav1.Time `json:"time"`
+
+	// State of the instance when the transition was recorded
+	// +optional
+	State InstanceStatus `json:"state,omitempty"`
+
+	// Event type (Normal or Warning)
+	Type string `json:"type"`
+
+	// Machine-readable reason
+	Reason string `json:"reason"`
+
+	// Human-readable message
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -365,6 +585,54 @@ func (d *DBInstance) GetNetworkPolicyName() string {
 	return d.Name + "-netpol"
 }
 
+func (d *DBInstance) GetGatewayRouteName() string {
+	return d.Name + "-route"
+}
+
+func (d *DBInstance) GetAllowlistName() string {
+	return d.Name + "-allowlist"
+}
+
+func (d *DBInstance) GetCredentialsRotationJobName() string {
+	return d.Name + "-rotate-credentials"
+}
+
+func (d *DBInstance) GetDataImportJobName() string {
+	return d.Name + "-import"
+}
+
+func (d *DBInstance) GetCloneJobName() string {
+	return d.Name + "-clone"
+}
+
+func (d *DBInstance) GetPodDisruptionBudgetName() string {
+	return d.Name + "-pdb"
+}
+
+// ProfilingEnabled reports whether slow operation capture was turned on
+func (d *DBInstance) ProfilingEnabled() bool {
+	return d.Spec.Profiling != nil && d.Spec.Profiling.Enabled
+}
+
+// SlowOpThresholdMs returns the slow operation threshold in milliseconds
+func (d *DBInstance) SlowOpThresholdMs() int32 {
+	if d.ProfilingEnabled() && d.Spec.Profiling.SlowOpThresholdMs > 0 {
+		return d.Spec.Profiling.SlowOpThresholdMs
+	}
+	return DefaultSlowOpThresholdMs
+}
+
+// IsMultiMember reports whether the mode runs several database members
+// that need spreading across nodes and a quorum-preserving PDB
+func (d *DBInstance) IsMultiMember() bool {
+	switch d.Spec.Mode {
+	case DBModeReplicaSet, DBModeSharded, DBModeSentinel, DBModeCluster:
+		return true
+	default:
+		return false
+	}
+}
+
 // State checks
 func (d *DBInstance) IsReady() bool {
 	return d.Status.State == StatusRunning
@@ -408,19 +676,18 @@ func (d *DBInstance) CanTransitionTo(target InstanceStatus) bool {
 	return false
 }
 
-// Mode validation
-func (d *DBInstance) IsValidMode() bool {
-	switch d.Spec.Type {
-	case DBTypeMongoDB:
-		return d.Spec.Mode == DBModeStandalone ||
-			d.Spec.Mode == DBModeReplicaSet ||
-			d.Spec.Mode == DBModeSharded
-	case DBTypeRedis:
-		return d.Spec.Mode == DBModeBasic ||
-			d.Spec.Mode == DBModeSentinel ||
-			d.Spec.Mode == DBModeCluster
-	default:
-		return false
+// AddTransition appends a transition to status.history and drops the oldest
+// entries beyond MaxStatusHistory
+func (d *DBInstance) AddTransition(eventType, reason, message string) {
+	d.Status.History = append(d.Status.History, StatusTransition{
+		Time:    metav1.Now(),
+		State:   d.Status.State,
+		Type:    eventType,
+		Reason:  reason,
+		Message: message,
+	})
+	if overflow := len(d.Status.History) - MaxStatusHistory; overflow > 0 {
+		d.Status.History = append([]StatusTransition(nil), d.Status.History[overflow:]...)
 	}
 }
 
@@ -440,18 +707,6 @@ func (d *DBInstance) GetCondition(conditionType string) *metav1.Condition {
 	return meta.FindStatusCondition(d.Status.Conditions, conditionType)
 }
 
-// GetDefaultPort returns default port based on DB type
-func (d *DBInstance) GetDefaultPort() int32 {
-	switch d.Spec.Type {
-	case DBTypeMongoDB:
-		return 27017
-	case DBTypeRedis:
-		return 6379
-	default:
-		return 0
-	}
-}
-
 // Billing helpers
 func (d *DBInstance) ShouldBeBilled() bool {
 	// Bill if running and last billed more than 1 hour ago
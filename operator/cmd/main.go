@@ -39,6 +39,10 @@ import (
 
 	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
 	"github.com/piper-hyowon/dBtree/operator/internal/controller"
+
+	// Database engines register themselves with the provisioner registry
+	_ "github.com/piper-hyowon/dBtree/operator/internal/provisioner/mongodb"
+	_ "github.com/piper-hyowon/dBtree/operator/internal/provisioner/redis"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -63,6 +67,7 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var gatewayEntryPoint, gatewayNamespace string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -84,6 +89,10 @@ func main() {
 	opts := zap.Options{
 		Development: false,
 	}
+	flag.StringVar(&gatewayEntryPoint, "db-gateway-entrypoint", "websecure",
+		"The Traefik entry point that routes database TLS connections by SNI hostname.")
+	flag.StringVar(&gatewayNamespace, "db-gateway-namespace", "kube-system",
+		"The namespace the SNI gateway runs in, allowed to reach database pods.")
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -203,8 +212,13 @@ func main() {
 	}
 
 	if err := (&controller.DBInstanceReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("dbinstance-controller"),
+		Gateway: controller.GatewayConfig{
+			EntryPoint: gatewayEntryPoint,
+			Namespace:  gatewayNamespace,
+		},
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DBInstance")
 		os.Exit(1)
@@ -0,0 +1,218 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+var middlewareTCPGVK = schema.GroupVersionKind{
+	Group:   "traefik.io",
+	Version: "v1alpha1",
+	Kind:    "MiddlewareTCP",
+}
+
+// reconcileSourceRanges enforces spec.allowedSourceRanges on every external path:
+// an ipAllowList middleware on the gateway route, and a NetworkPolicy with
+// ipBlock rules for NodePort traffic. An empty list removes the restrictions.
+// 적용된 목록은 status.appliedSourceRanges 에 기록
+func (r *DBInstanceReconciler) reconcileSourceRanges(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) error {
+	ranges := instance.Spec.AllowedSourceRanges
+
+	if err := r.reconcileAllowlistMiddleware(ctx, instance, ranges); err != nil {
+		return err
+	}
+	if err := r.reconcileAllowlistPolicy(ctx, instance, engine, ranges); err != nil {
+		return err
+	}
+	if err := r.reconcileExternalTrafficPolicy(ctx, instance, ranges); err != nil {
+		return err
+	}
+
+	if !slices.Equal(instance.Status.AppliedSourceRanges, ranges) {
+		now := metav1.Now()
+		instance.Status.AppliedSourceRanges = slices.Clone(ranges)
+		instance.Status.SourceRangesAppliedAt = &now
+
+		message := "External access allowed from any source"
+		if len(ranges) > 0 {
+			message = "External access limited to " + strings.Join(ranges, ", ")
+		}
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonSourceRangesApplied, message)
+	}
+
+	return nil
+}
+
+// reconcileAllowlistMiddleware keeps the gateway's ipAllowList middleware in sync
+func (r *DBInstanceReconciler) reconcileAllowlistMiddleware(ctx context.Context, instance *dbtreev1.DBInstance, ranges []string) error {
+	middleware := &unstructured.Unstructured{}
+	middleware.SetGroupVersionKind(middlewareTCPGVK)
+	middleware.SetName(instance.GetAllowlistName())
+	middleware.SetNamespace(instance.GetUserNamespace())
+
+	if len(ranges) == 0 || instance.Spec.ExternalHost == "" {
+		if err := r.Delete(ctx, middleware); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete allowlist middleware: %w", err)
+		}
+		return nil
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, middleware, func() error {
+		sourceRange := make([]interface{}, len(ranges))
+		for i, cidr := range ranges {
+			sourceRange[i] = cidr
+		}
+		if err := unstructured.SetNestedSlice(middleware.Object, sourceRange, "spec", "ipAllowList", "sourceRange"); err != nil {
+			return err
+		}
+		return controllerutil.SetControllerReference(instance, middleware, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile allowlist middleware: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileAllowlistPolicy limits ingress to the database port to the namespace,
+// the gateway and the allowed CIDRs. Without ranges the policy is removed.
+func (r *DBInstanceReconciler) reconcileAllowlistPolicy(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine, ranges []string) error {
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetAllowlistName(),
+			Namespace: instance.GetUserNamespace(),
+		},
+	}
+
+	if len(ranges) == 0 {
+		if err := r.Delete(ctx, np); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete allowlist policy: %w", err)
+		}
+		return nil
+	}
+
+	peers := []networkingv1.NetworkPolicyPeer{
+		{
+			// Allow from same namespace (backup jobs 등)
+			PodSelector: &metav1.LabelSelector{},
+		},
+		{
+			// Allow from the SNI gateway, which applies its own allowlist
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": r.Gateway.Namespace,
+				},
+			},
+		},
+	}
+	for _, cidr := range ranges {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, np, func() error {
+		np.Labels = map[string]string{
+			"app.kubernetes.io/instance":  instance.Name,
+			"app.kubernetes.io/component": "allowlist",
+			"app.kubernetes.io/part-of":   "dbtree",
+		}
+		np.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/instance":  instance.Name,
+					"app.kubernetes.io/component": "database",
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: peers,
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Port: &intstr.IntOrString{
+								Type:   intstr.Int,
+								IntVal: engine.DefaultPort,
+							},
+							Protocol: &protocolTCP,
+						},
+					},
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(instance, np, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile allowlist policy: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileExternalTrafficPolicy preserves client IPs on the NodePort Service
+// while an allowlist is set, so ipBlock rules see the real source address.
+// Local 정책에서는 파드가 있는 노드로만 NodePort 접속 가능
+func (r *DBInstanceReconciler) reconcileExternalTrafficPolicy(ctx context.Context, instance *dbtreev1.DBInstance, ranges []string) error {
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.GetServiceName(),
+		Namespace: instance.GetUserNamespace(),
+	}, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get service: %w", err)
+	}
+
+	if svc.Spec.Type != corev1.ServiceTypeNodePort {
+		return nil
+	}
+
+	desired := corev1.ServiceExternalTrafficPolicyCluster
+	if len(ranges) > 0 {
+		desired = corev1.ServiceExternalTrafficPolicyLocal
+	}
+	if svc.Spec.ExternalTrafficPolicy == desired {
+		return nil
+	}
+
+	svc.Spec.ExternalTrafficPolicy = desired
+	if err := r.Update(ctx, svc); err != nil {
+		return fmt.Errorf("failed to update service traffic policy: %w", err)
+	}
+	return nil
+}
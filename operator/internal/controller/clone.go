@@ -0,0 +1,310 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+// cloneDeadlineSeconds bounds a single copy from the source instance or backup
+const cloneDeadlineSeconds = 3600
+
+// startClone moves a freshly provisioned instance to restoring so that
+// handleRestoring fills it from spec.cloneFrom before it becomes running
+func (r *DBInstanceReconciler) startClone(ctx context.Context, instance *dbtreev1.DBInstance) (ctrl.Result, error) {
+	source := instance.Spec.CloneFrom
+
+	now := metav1.Now()
+	instance.Status.State = dbtreev1.StatusRestoring
+	instance.Status.StatusReason = fmt.Sprintf("Copying data from %s", source.Instance)
+	instance.Status.Clone = &dbtreev1.CloneStatus{
+		Phase:     dbtreev1.CloneRunning,
+		StartedAt: &now,
+	}
+
+	message := fmt.Sprintf("Copying live data from %s", source.Instance)
+	if source.Archive != "" {
+		message = fmt.Sprintf("Restoring backup %s of %s", source.Archive, source.Instance)
+	}
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonCloneStarted, message)
+
+	if err := r.updateStatus(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// handleRestoring runs the clone job and marks the instance running once the
+// copy succeeded. A failed copy leaves the instance in error
+func (r *DBInstanceReconciler) handleRestoring(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) (ctrl.Result, error) {
+	if instance.Spec.CloneFrom == nil || instance.Status.Clone == nil {
+		return r.markProvisioned(ctx, instance, engine)
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.GetCloneJobName(),
+		Namespace: instance.GetUserNamespace(),
+	}, job)
+	if apierrors.IsNotFound(err) {
+		source := instance.Spec.CloneFrom
+		sourceInstance := &dbtreev1.DBInstance{}
+		if err := r.Get(ctx, types.NamespacedName{Name: source.Instance, Namespace: instance.Namespace}, sourceInstance); err != nil {
+			if apierrors.IsNotFound(err) {
+				return r.failClone(ctx, instance, nil, fmt.Sprintf("source instance %s not found", source.Instance))
+			}
+			return ctrl.Result{}, err
+		}
+
+		container, volumes, err := cloneContainer(instance, sourceInstance, engine, source)
+		if err != nil {
+			return r.failClone(ctx, instance, nil, err.Error())
+		}
+
+		// 백업 PVC 는 ReadWriteOnce 라 이미 붙어 있는 노드에서만 마운트 가능
+		nodeName := ""
+		if source.Archive != "" {
+			if nodeName, err = r.claimNode(ctx, sourceInstance.GetUserNamespace(), sourceInstance.GetBackupPVCName()); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if err := r.createCloneJob(ctx, instance, container, volumes, nodeName); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get clone job: %w", err)
+	}
+
+	_, succeeded, finished := jobResult(job)
+	if !finished {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if !succeeded {
+		return r.failClone(ctx, instance, job, r.jobFailureMessage(ctx, job))
+	}
+
+	if err := r.deleteJob(ctx, job); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to delete clone job", "job", job.Name)
+	}
+	now := metav1.Now()
+	instance.Status.Clone.Phase = dbtreev1.CloneSucceeded
+	instance.Status.Clone.CompletedAt = &now
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonCloneSucceeded,
+		fmt.Sprintf("Data copied from %s", instance.Spec.CloneFrom.Instance))
+
+	return r.markProvisioned(ctx, instance, engine)
+}
+
+// failClone records the failed copy and moves the instance to error
+func (r *DBInstanceReconciler) failClone(ctx context.Context, instance *dbtreev1.DBInstance,
+	job *batchv1.Job, message string) (ctrl.Result, error) {
+	if job != nil {
+		if err := r.deleteJob(ctx, job); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to delete clone job", "job", job.Name)
+		}
+	}
+
+	now := metav1.Now()
+	instance.Status.Clone.Phase = dbtreev1.CloneFailed
+	instance.Status.Clone.Message = message
+	instance.Status.Clone.CompletedAt = &now
+
+	return r.setErrorCondition(ctx, instance, EventReasonCloneFailed,
+		fmt.Sprintf("Failed to copy data from %s: %s", instance.Spec.CloneFrom.Instance, message))
+}
+
+// cloneContainer builds the container that copies the source into the instance.
+// With an archive the engine's clone restore command runs against the source's backup
+// PVC, otherwise the engine's clone command reads the live source instance
+func cloneContainer(instance, sourceInstance *dbtreev1.DBInstance, engine *provisioner.Engine,
+	source *dbtreev1.CloneSource) (corev1.Container, []corev1.Volume, error) {
+	if sourceInstance.Spec.Type != instance.Spec.Type {
+		return corev1.Container{}, nil, fmt.Errorf("source instance %s is %s, not %s",
+			source.Instance, sourceInstance.Spec.Type, instance.Spec.Type)
+	}
+
+	container := corev1.Container{
+		Name: "clone",
+		Env: []corev1.EnvVar{
+			{Name: "DB_HOST", Value: instance.GetServiceName()},
+			{Name: "DB_PORT", Value: fmt.Sprintf("%d", engine.DefaultPort)},
+		},
+		EnvFrom: []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: instance.GetSecretName(),
+					},
+				},
+			},
+		},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	var volumes []corev1.Volume
+
+	if source.Archive != "" {
+		if engine.Backup == nil || engine.Clone == nil || engine.Clone.RestoreCommand == nil {
+			return corev1.Container{}, nil, fmt.Errorf("cloning from backups is not supported for %s", instance.Spec.Type)
+		}
+		archive := path.Clean(source.Archive)
+		if path.IsAbs(archive) || strings.HasPrefix(archive, "..") {
+			return corev1.Container{}, nil, fmt.Errorf("invalid backup archive %s", source.Archive)
+		}
+
+		container.Image = engine.Backup.Image
+		container.Command = engine.Clone.RestoreCommand(archive)
+		container.VolumeMounts = []corev1.VolumeMount{
+			{Name: "backup-storage", MountPath: "/backup", ReadOnly: true},
+		}
+		volumes = []corev1.Volume{
+			{
+				Name: "backup-storage",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: sourceInstance.GetBackupPVCName(),
+						ReadOnly:  true,
+					},
+				},
+			},
+		}
+	} else {
+		if engine.Clone == nil {
+			return corev1.Container{}, nil, fmt.Errorf("cloning is not supported for %s", instance.Spec.Type)
+		}
+
+		sourceSecret := func(key string, optional bool) *corev1.EnvVarSource {
+			return &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: sourceInstance.GetSecretName()},
+					Key:                  key,
+					Optional:             ptr.To(optional),
+				},
+			}
+		}
+		container.Image = engine.Clone.Image
+		container.Command = engine.Clone.CloneCommand(instance)
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "SOURCE_HOST", Value: sourceInstance.GetServiceName()},
+			corev1.EnvVar{Name: "SOURCE_PORT", Value: fmt.Sprintf("%d", engine.DefaultPort)},
+			// Redis 는 사용자명 없이 비밀번호만 사용
+			corev1.EnvVar{Name: "SOURCE_USERNAME", ValueFrom: sourceSecret("username", true)},
+			corev1.EnvVar{Name: "SOURCE_PASSWORD", ValueFrom: sourceSecret("password", false)},
+		)
+	}
+
+	return container, volumes, nil
+}
+
+// claimNode returns the node of a running pod that mounts the claim, or ""
+// when no pod holds it and the scheduler may pick any node
+func (r *DBInstanceReconciler) claimNode(ctx context.Context, namespace, claimName string) (string, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return "", fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName == "" ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+				return pod.Spec.NodeName, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// createCloneJob runs the copy container once, on nodeName when it is set
+func (r *DBInstanceReconciler) createCloneJob(ctx context.Context, instance *dbtreev1.DBInstance,
+	container corev1.Container, volumes []corev1.Volume, nodeName string) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetCloneJobName(),
+			Namespace: instance.GetUserNamespace(),
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":  instance.Name,
+				"app.kubernetes.io/component": "clone",
+				"app.kubernetes.io/part-of":   "dbtree",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			// 실패하면 인스턴스를 error 로 두고 사용자가 삭제 후 다시 생성
+			BackoffLimit:            ptr.To(int32(0)),
+			ActiveDeadlineSeconds:   ptr.To(int64(cloneDeadlineSeconds)),
+			TTLSecondsAfterFinished: ptr.To(int32(3600)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					AutomountServiceAccountToken: ptr.To(false),
+					Containers:                   []corev1.Container{container},
+					Volumes:                      volumes,
+				},
+			},
+		},
+	}
+
+	if nodeName != "" {
+		job.Spec.Template.Spec.Affinity = &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{
+									Key:      corev1.LabelHostname,
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{nodeName},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if err := controllerutil.SetControllerReference(instance, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create clone job: %w", err)
+	}
+	return nil
+}
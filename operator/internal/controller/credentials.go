@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+const (
+	// PendingPasswordKey is the secret key the backend writes the new password to
+	// before requesting a rotation
+	PendingPasswordKey = "pending-password"
+
+	// AnnotationCredentialsRotation marks which rotation request a job belongs to
+	AnnotationCredentialsRotation = "dbtree.cloud/credentials-rotation"
+)
+
+// reconcileCredentialsRotation applies a requested password rotation to the
+// running database. The new password is taken from the secret's
+// pending-password key and set live by the engine's rotation job; once the job
+// succeeds the secret is rewritten so restarts and backups use the new password.
+// Returns true while a rotation is still in progress.
+func (r *DBInstanceReconciler) reconcileCredentialsRotation(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) (bool, error) {
+	requested := instance.Spec.CredentialsRotation
+	if requested == "" || requested == instance.Status.CredentialsRotation {
+		return false, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.GetSecretName(),
+		Namespace: instance.GetUserNamespace(),
+	}, secret); err != nil {
+		return false, fmt.Errorf("failed to get secret: %w", err)
+	}
+
+	password, ok := secret.Data[PendingPasswordKey]
+	if !ok {
+		// 시크릿 교체 후 status 저장만 실패한 경우
+		instance.Status.CredentialsRotation = requested
+		return false, nil
+	}
+
+	if engine.Credentials == nil {
+		return false, r.finishCredentialsRotation(ctx, instance, secret, nil,
+			fmt.Sprintf("Credentials rotation is not supported for %s", instance.Spec.Type))
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.GetCredentialsRotationJobName(),
+		Namespace: instance.GetUserNamespace(),
+	}, job)
+	if apierrors.IsNotFound(err) {
+		return true, r.createCredentialsRotationJob(ctx, instance, engine, requested)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get rotation job: %w", err)
+	}
+
+	// 이전 요청의 Job 이 남아 있으면 지우고 다음 reconcile 에서 새로 생성
+	if job.Annotations[AnnotationCredentialsRotation] != requested {
+		return true, r.deleteJob(ctx, job)
+	}
+
+	_, succeeded, finished := jobResult(job)
+	if !finished {
+		return true, nil
+	}
+
+	if !succeeded {
+		return false, r.finishCredentialsRotation(ctx, instance, secret, job,
+			fmt.Sprintf("Rotation job %s failed, previous credentials are still active", job.Name))
+	}
+
+	for key, value := range engine.Credentials.SecretData(instance, secret.Data, string(password)) {
+		secret.Data[key] = value
+	}
+	if err := r.finishCredentialsRotation(ctx, instance, secret, job, ""); err != nil {
+		return false, err
+	}
+
+	now := metav1.Now()
+	instance.Status.CredentialsRotatedAt = &now
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonCredentialsRotated,
+		"Root password rotated without restarting pods")
+	return false, nil
+}
+
+// finishCredentialsRotation drops the pending password, removes the job and
+// marks the request as processed. A non-empty failure is recorded as a Warning
+func (r *DBInstanceReconciler) finishCredentialsRotation(ctx context.Context, instance *dbtreev1.DBInstance,
+	secret *corev1.Secret, job *batchv1.Job, failure string) error {
+	delete(secret.Data, PendingPasswordKey)
+	if err := r.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update secret: %w", err)
+	}
+
+	if job != nil {
+		if err := r.deleteJob(ctx, job); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to delete rotation job", "job", job.Name)
+		}
+	}
+
+	instance.Status.CredentialsRotation = instance.Spec.CredentialsRotation
+	if failure != "" {
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonCredentialsRotationFailed, failure)
+	}
+	return nil
+}
+
+// createCredentialsRotationJob starts the engine's rotation command against the instance
+func (r *DBInstanceReconciler) createCredentialsRotationJob(ctx context.Context, instance *dbtreev1.DBInstance,
+	engine *provisioner.Engine, requested string) error {
+	secretKey := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: instance.GetSecretName()},
+				Key:                  key,
+			},
+		}
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetCredentialsRotationJobName(),
+			Namespace: instance.GetUserNamespace(),
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":  instance.Name,
+				"app.kubernetes.io/component": "credentials-rotation",
+				"app.kubernetes.io/part-of":   "dbtree",
+			},
+			Annotations: map[string]string{
+				AnnotationCredentialsRotation: requested,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(3)),
+			TTLSecondsAfterFinished: ptr.To(int32(3600)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "rotate",
+							Image:   engine.Credentials.Image,
+							Command: engine.Credentials.RotateCommand(instance),
+							Env: []corev1.EnvVar{
+								{Name: "DB_HOST", Value: instance.GetServiceName()},
+								{Name: "DB_PORT", Value: fmt.Sprintf("%d", engine.DefaultPort)},
+								{Name: "CURRENT_PASSWORD", ValueFrom: secretKey("password")},
+								{Name: "NEW_PASSWORD", ValueFrom: secretKey(PendingPasswordKey)},
+							},
+							EnvFrom: []corev1.EnvFromSource{
+								{
+									SecretRef: &corev1.SecretEnvSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: instance.GetSecretName(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(instance, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create rotation job: %w", err)
+	}
+	return nil
+}
+
+func (r *DBInstanceReconciler) deleteJob(ctx context.Context, job *batchv1.Job) error {
+	err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete job %s: %w", job.Name, err)
+	}
+	return nil
+}
@@ -21,17 +21,20 @@ import (
 	"fmt"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
+	"sort"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,8 +43,6 @@ import (
 
 	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
 	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
-	"github.com/piper-hyowon/dBtree/operator/internal/provisioner/mongodb"
-	"github.com/piper-hyowon/dBtree/operator/internal/provisioner/redis"
 )
 
 const (
@@ -55,6 +56,29 @@ const (
 
 	// Annotations
 	AnnotationBackendID = "dbtree.cloud/backend-id"
+
+	// Event reasons (also stored in status.history)
+	EventReasonProvisioningStarted       = "ProvisioningStarted"
+	EventReasonProvisioned               = "Provisioned"
+	EventReasonReprovisioning            = "Reprovisioning"
+	EventReasonReadinessLost             = "ReadinessLost"
+	EventReasonReadinessRestored         = "ReadinessRestored"
+	EventReasonPaused                    = "Paused"
+	EventReasonStopped                   = "Stopped"
+	EventReasonResumed                   = "Resumed"
+	EventReasonBackupSucceeded           = "BackupSucceeded"
+	EventReasonBackupFailed              = "BackupFailed"
+	EventReasonDeletionStarted           = "DeletionStarted"
+	EventReasonResourceDeleted           = "ResourceDeleted"
+	EventReasonDeletionCompleted         = "DeletionCompleted"
+	EventReasonSourceRangesApplied       = "SourceRangesApplied"
+	EventReasonCredentialsRotated        = "CredentialsRotated"
+	EventReasonCredentialsRotationFailed = "CredentialsRotationFailed"
+	EventReasonDataImportSucceeded       = "DataImportSucceeded"
+	EventReasonDataImportFailed          = "DataImportFailed"
+	EventReasonCloneStarted              = "CloneStarted"
+	EventReasonCloneSucceeded            = "CloneSucceeded"
+	EventReasonCloneFailed               = "CloneFailed"
 )
 
 var (
@@ -65,7 +89,9 @@ var (
 // DBInstanceReconciler reconciles a DBInstance object
 type DBInstanceReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Gateway  GatewayConfig
 }
 
 // +kubebuilder:rbac:groups=dbtree.cloud,resources=dbinstances,verbs=get;list;watch;create;update;patch;delete
@@ -77,9 +103,13 @@ type DBInstanceReconciler struct {
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=traefik.io,resources=ingressroutetcps;middlewaretcps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *DBInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
@@ -109,19 +139,26 @@ func (r *DBInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// 네임스페이스는 백엔드에서 이미 생성함
 
-	// Get provisioner based on database type
-	prov := r.getProvisioner(instance.Spec.Type)
-	if prov == nil {
+	// Get engine based on database type
+	engine, ok := provisioner.Lookup(instance.Spec.Type)
+	if !ok {
 		return r.setErrorCondition(ctx, instance, "InvalidDatabaseType",
 			fmt.Sprintf("Unsupported database type: %s", instance.Spec.Type))
 	}
+	if !engine.SupportsMode(instance.Spec.Mode) {
+		return r.setErrorCondition(ctx, instance, "InvalidDatabaseMode",
+			fmt.Sprintf("Unsupported mode %s for database type %s", instance.Spec.Mode, instance.Spec.Type))
+	}
+	prov := engine.NewProvisioner(r.Client, r.Scheme)
 
 	// Handle based on current state
 	switch instance.Status.State {
 	case "", dbtreev1.StatusProvisioning:
-		return r.handleProvisioning(ctx, instance, prov)
+		return r.handleProvisioning(ctx, instance, engine, prov)
+	case dbtreev1.StatusRestoring:
+		return r.handleRestoring(ctx, instance, engine)
 	case dbtreev1.StatusRunning:
-		return r.handleRunning(ctx, instance, prov)
+		return r.handleRunning(ctx, instance, engine, prov)
 	case dbtreev1.StatusPaused:
 		return r.handlePaused(ctx, instance, prov)
 	case dbtreev1.StatusStopped:
@@ -141,7 +178,7 @@ func (r *DBInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 // handleProvisioning creates all required resources
-func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance *dbtreev1.DBInstance, prov provisioner.Provisioner) (ctrl.Result, error) {
+func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine, prov provisioner.Provisioner) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log.Info("Handling provisioning state")
 
@@ -149,6 +186,7 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 	if instance.Status.State == "" {
 		instance.Status.State = dbtreev1.StatusProvisioning
 		instance.Status.StatusReason = "Starting provisioning"
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonProvisioningStarted, "Starting provisioning")
 		if err := r.updateStatus(ctx, instance); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -160,15 +198,25 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 		return r.setErrorCondition(ctx, instance, "ProvisioningFailed", err.Error())
 	}
 
+	// Apply the external access allowlist, then route the SNI hostname through the shared gateway
+	if err := r.reconcileSourceRanges(ctx, instance, engine); err != nil {
+		log.Error(err, "Failed to apply source ranges")
+		return r.setErrorCondition(ctx, instance, "SourceRangesFailed", err.Error())
+	}
+	if err := r.reconcileGatewayRoute(ctx, instance, engine); err != nil {
+		log.Error(err, "Failed to reconcile gateway route")
+		return r.setErrorCondition(ctx, instance, "GatewayRouteFailed", err.Error())
+	}
+
 	//// Create NetworkPolicy
-	//if err := r.createNetworkPolicy(ctx, instance); err != nil {
+	//if err := r.createNetworkPolicy(ctx, instance, engine); err != nil {
 	//	log.Error(err, "Failed to create NetworkPolicy")
 	//	return r.setErrorCondition(ctx, instance, "NetworkPolicyCreationFailed", err.Error())
 	//}
 
 	// Create backup CronJob if enabled
 	if instance.NeedsBackup() {
-		if err := r.createBackupCronJob(ctx, instance); err != nil {
+		if err := r.createBackupCronJob(ctx, instance, engine); err != nil {
 			log.Error(err, "Failed to create backup CronJob")
 			return r.setErrorCondition(ctx, instance, "BackupCreationFailed", err.Error())
 		}
@@ -199,6 +247,18 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
+	// 복제 요청이면 데이터를 채운 뒤 running 으로 전환
+	if instance.Spec.CloneFrom != nil && instance.Status.Clone == nil {
+		return r.startClone(ctx, instance)
+	}
+
+	return r.markProvisioned(ctx, instance, engine)
+}
+
+// markProvisioned moves a provisioned instance to running
+func (r *DBInstanceReconciler) markProvisioned(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
 	// Update status to running
 	instance.Status.State = dbtreev1.StatusRunning
 	instance.Status.StatusReason = "Provisioning completed successfully"
@@ -207,7 +267,7 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 
 	// Set endpoint and port
 	instance.Status.Endpoint = instance.GetServiceName() + "." + instance.Namespace + ".svc.cluster.local"
-	instance.Status.Port = instance.GetDefaultPort()
+	instance.Status.Port = engine.DefaultPort
 	instance.Status.SecretRef = instance.Spec.SecretRef.Name
 
 	// Set conditions
@@ -215,6 +275,8 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 		"ProvisioningSucceeded", "All resources created successfully")
 	instance.SetCondition(ConditionTypeReady, metav1.ConditionTrue,
 		"InstanceReady", "Database instance is ready")
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonProvisioned,
+		fmt.Sprintf("Database instance is ready at %s:%d", instance.Status.Endpoint, instance.Status.Port))
 
 	if err := r.updateStatus(ctx, instance); err != nil {
 		return ctrl.Result{}, err
@@ -228,7 +290,7 @@ func (r *DBInstanceReconciler) handleProvisioning(ctx context.Context, instance
 }
 
 // handleRunning monitors the running instance
-func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtreev1.DBInstance, prov provisioner.Provisioner) (ctrl.Result, error) {
+func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine, prov provisioner.Provisioner) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 
 	// Check if StatefulSet is ready
@@ -241,6 +303,7 @@ func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtr
 			// StatefulSet not found, transition back to provisioning
 			instance.Status.State = dbtreev1.StatusProvisioning
 			instance.Status.StatusReason = "StatefulSet not found, reprovisioning"
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonReprovisioning, instance.Status.StatusReason)
 			if err := r.updateStatus(ctx, instance); err != nil {
 				return ctrl.Result{}, err
 			}
@@ -249,11 +312,43 @@ func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtr
 		return ctrl.Result{}, err
 	}
 
+	// Back from paused/stopped
+	if instance.Status.PausedAt != nil {
+		log.Info("Instance resumed")
+		instance.Status.PausedAt = nil
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonResumed, "Instance resumed")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, r.updateStatus(ctx, instance)
+	}
+
+	// Apply spec changes before the readiness check, so a resize can also fix
+	// pods that are not ready. 적용에 성공해야만 observedGeneration 을 올림
+	if instance.Status.ObservedGeneration != instance.Generation {
+		log.Info("Spec changed, updating resources", "generation", instance.Generation)
+		if err := prov.Update(ctx, instance); err != nil {
+			log.Error(err, "Failed to update resources")
+			return r.setErrorCondition(ctx, instance, "UpdateFailed", err.Error())
+		}
+		if err := r.reconcileSourceRanges(ctx, instance, engine); err != nil {
+			log.Error(err, "Failed to apply source ranges")
+			return r.setErrorCondition(ctx, instance, "SourceRangesFailed", err.Error())
+		}
+		if err := r.reconcileGatewayRoute(ctx, instance, engine); err != nil {
+			log.Error(err, "Failed to reconcile gateway route")
+			return r.setErrorCondition(ctx, instance, "GatewayRouteFailed", err.Error())
+		}
+		instance.Status.ObservedGeneration = instance.Generation
+	}
+
 	// Check readiness
+	wasReady := instance.GetCondition(ConditionTypeReady)
 	if sts.Status.ReadyReplicas != *sts.Spec.Replicas {
+		message := fmt.Sprintf("Only %d/%d pods are ready",
+			sts.Status.ReadyReplicas, *sts.Spec.Replicas)
+		if wasReady != nil && wasReady.Status == metav1.ConditionTrue {
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonReadinessLost, message)
+		}
 		instance.SetCondition(ConditionTypeReady, metav1.ConditionFalse,
-			"PodsNotReady", fmt.Sprintf("Only %d/%d pods are ready",
-				sts.Status.ReadyReplicas, *sts.Spec.Replicas))
+			"PodsNotReady", message)
 		if err := r.updateStatus(ctx, instance); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -261,11 +356,29 @@ func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtr
 	}
 
 	// All good, ensure Ready condition is True
+	if wasReady != nil && wasReady.Status != metav1.ConditionTrue {
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonReadinessRestored, "All pods are ready")
+	}
 	instance.SetCondition(ConditionTypeReady, metav1.ConditionTrue,
 		"AllPodsReady", "All pods are ready")
 
+	// Revert anything changed outside the operator
+	r.correctDrift(ctx, instance, prov)
+
+	rotating, err := r.reconcileCredentialsRotation(ctx, instance, engine)
+	if err != nil {
+		log.Error(err, "Failed to rotate credentials")
+	}
+
+	importing, err := r.reconcileDataImport(ctx, instance, engine)
+	if err != nil {
+		log.Error(err, "Failed to run data import")
+	}
+
 	// Check if backup configuration changed
 	if instance.NeedsBackup() {
+		r.recordBackupOutcomes(ctx, instance)
+
 		cronJob := &batchv1.CronJob{}
 		err := r.Get(ctx, types.NamespacedName{
 			Name:      instance.GetBackupCronJobName(),
@@ -276,7 +389,7 @@ func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtr
 			if apierrors.IsNotFound(err) {
 				// Backup enabled but CronJob doesn't exist, create it
 				log.Info("Creating backup CronJob as backup is now enabled")
-				if err := r.createBackupCronJob(ctx, instance); err != nil {
+				if err := r.createBackupCronJob(ctx, instance, engine); err != nil {
 					log.Error(err, "Failed to create backup CronJob")
 				}
 			}
@@ -308,6 +421,11 @@ func (r *DBInstanceReconciler) handleRunning(ctx context.Context, instance *dbtr
 		}
 	}
 
+	// Rotation, import job 진행 중이면 완료될 때까지 짧게 재확인
+	if rotating || importing {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, r.updateStatus(ctx, instance)
+	}
+
 	// Requeue after 5 minutes to check again
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, r.updateStatus(ctx, instance)
 }
@@ -341,6 +459,12 @@ func (r *DBInstanceReconciler) handlePaused(ctx context.Context, instance *dbtre
 	if instance.Status.PausedAt == nil {
 		now := metav1.Now()
 		instance.Status.PausedAt = &now
+
+		reason := EventReasonPaused
+		if instance.Status.State == dbtreev1.StatusStopped {
+			reason = EventReasonStopped
+		}
+		r.recordEvent(instance, corev1.EventTypeNormal, reason, "Scaled down to 0 replicas")
 	}
 
 	instance.SetCondition(ConditionTypeReady, metav1.ConditionFalse,
@@ -377,6 +501,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 
 	if controllerutil.ContainsFinalizer(instance, dbInstanceFinalizer) {
 		log.Info("Handling deletion")
+		r.Recorder.Event(instance, corev1.EventTypeNormal, EventReasonDeletionStarted, "Deleting instance resources")
 
 		namespace := instance.GetUserNamespace()
 
@@ -395,6 +520,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 					log.Error(err, "Failed to delete service", "name", svc.Name)
 				} else {
 					log.Info("Deleted service", "name", svc.Name)
+					r.recordDeleted(instance, "Service", svc.Name)
 				}
 			}
 		}
@@ -410,6 +536,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 				log.Error(err, "Failed to delete external service")
 			} else {
 				log.Info("Deleted external service", "name", externalServiceName)
+				r.recordDeleted(instance, "Service", externalServiceName)
 			}
 		}
 
@@ -423,6 +550,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 				log.Error(err, "Failed to delete ConfigMap", "name", configMap.Name)
 			} else {
 				log.Info("Deleted ConfigMap", "name", configMap.Name)
+				r.recordDeleted(instance, "ConfigMap", configMap.Name)
 			}
 		}
 
@@ -436,6 +564,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 				log.Error(err, "Failed to delete Secret", "name", secret.Name)
 			} else {
 				log.Info("Deleted Secret", "name", secret.Name)
+				r.recordDeleted(instance, "Secret", secret.Name)
 			}
 		}
 
@@ -454,6 +583,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 					log.Error(err, "Failed to delete PVC", "name", pvc.Name)
 				} else {
 					log.Info("Deleted PVC", "name", pvc.Name)
+					r.recordDeleted(instance, "PersistentVolumeClaim", pvc.Name)
 				}
 			}
 		}
@@ -468,6 +598,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 				log.Error(err, "Failed to delete NetworkPolicy")
 			} else {
 				log.Info("Deleted NetworkPolicy", "name", netpol.Name)
+				r.recordDeleted(instance, "NetworkPolicy", netpol.Name)
 			}
 		}
 
@@ -481,17 +612,19 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 				log.Error(err, "Failed to delete backup CronJob")
 			} else {
 				log.Info("Deleted backup CronJob", "name", cronJob.Name)
+				r.recordDeleted(instance, "CronJob", cronJob.Name)
 			}
 		}
 
 		// 8. Provisioner를 통한 추가 정리
-		prov := r.getProvisioner(instance.Spec.Type)
-		if prov != nil {
-			if err := prov.Delete(ctx, instance); err != nil {
+		if engine, ok := provisioner.Lookup(instance.Spec.Type); ok {
+			if err := engine.NewProvisioner(r.Client, r.Scheme).Delete(ctx, instance); err != nil {
 				log.Error(err, "Failed to delete resources via provisioner")
 			}
 		}
 
+		r.Recorder.Event(instance, corev1.EventTypeNormal, EventReasonDeletionCompleted, "All instance resources deleted")
+
 		// Finalizer 제거
 		controllerutil.RemoveFinalizer(instance, dbInstanceFinalizer)
 		if err := r.Update(ctx, instance); err != nil {
@@ -503,7 +636,7 @@ func (r *DBInstanceReconciler) handleDeletion(ctx context.Context, instance *dbt
 }
 
 // createNetworkPolicy creates a NetworkPolicy for the instance
-func (r *DBInstanceReconciler) createNetworkPolicy(ctx context.Context, instance *dbtreev1.DBInstance) error {
+func (r *DBInstanceReconciler) createNetworkPolicy(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) error {
 	labels := map[string]string{
 		"app.kubernetes.io/name":      string(instance.Spec.Type),
 		"app.kubernetes.io/instance":  instance.Name,
@@ -533,12 +666,20 @@ func (r *DBInstanceReconciler) createNetworkPolicy(ctx context.Context, instance
 							// Allow from same namespace
 							PodSelector: &metav1.LabelSelector{},
 						},
+						{
+							// Allow from the SNI gateway
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"kubernetes.io/metadata.name": r.Gateway.Namespace,
+								},
+							},
+						},
 					},
 					Ports: []networkingv1.NetworkPolicyPort{
 						{
 							Port: &intstr.IntOrString{
 								Type:   intstr.Int,
-								IntVal: int32(instance.GetDefaultPort()),
+								IntVal: engine.DefaultPort,
 							},
 							Protocol: &protocolTCP,
 						},
@@ -588,7 +729,11 @@ func (r *DBInstanceReconciler) createNetworkPolicy(ctx context.Context, instance
 }
 
 // createBackupCronJob creates a CronJob for backup
-func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance *dbtreev1.DBInstance) error {
+func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) error {
+	if engine.Backup == nil {
+		return fmt.Errorf("backup is not supported for database type %s", instance.Spec.Type)
+	}
+
 	if err := r.createBackupPVC(ctx, instance); err != nil {
 		return fmt.Errorf("failed to create backup PVC: %w", err)
 	}
@@ -596,8 +741,8 @@ func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance
 	// Backup container configuration
 	backupContainer := corev1.Container{
 		Name:    "backup",
-		Image:   r.getBackupImage(instance.Spec.Type),
-		Command: r.getBackupCommand(instance.Spec.Type),
+		Image:   engine.Backup.Image,
+		Command: engine.Backup.BackupCommand(),
 		Env: []corev1.EnvVar{
 			{
 				Name:  "DB_HOST",
@@ -605,7 +750,7 @@ func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance
 			},
 			{
 				Name:  "DB_PORT",
-				Value: fmt.Sprintf("%d", instance.GetDefaultPort()),
+				Value: fmt.Sprintf("%d", engine.DefaultPort),
 			},
 			{
 				Name:  "BACKUP_RETENTION_DAYS",
@@ -646,6 +791,9 @@ func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance
 			SuccessfulJobsHistoryLimit: ptr.To(int32(3)),
 			FailedJobsHistoryLimit:     ptr.To(int32(1)),
 			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: backupJobLabels(instance),
+				},
 				Spec: batchv1.JobSpec{
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
@@ -677,6 +825,7 @@ func (r *DBInstanceReconciler) createBackupCronJob(ctx context.Context, instance
 	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, cronJob, func() error {
 		// Update schedule if changed
 		cronJob.Spec.Schedule = instance.Spec.Backup.Schedule
+		cronJob.Spec.JobTemplate.Labels = backupJobLabels(instance)
 		return nil
 	})
 
@@ -737,114 +886,111 @@ func (r *DBInstanceReconciler) createBackupPVC(ctx context.Context, instance *db
 	return nil
 }
 
-// getProvisioner returns the appropriate provisioner for the database type
-func (r *DBInstanceReconciler) getProvisioner(dbType dbtreev1.DBType) provisioner.Provisioner {
-	switch dbType {
-	case dbtreev1.DBTypeMongoDB:
-		return mongodb.NewProvisioner(r.Client, r.Scheme)
-	case dbtreev1.DBTypeRedis:
-		return redis.NewProvisioner(r.Client, r.Scheme)
-	default:
-		return nil
+// correctDrift reverts out-of-band changes to owned resources and records an Event per correction
+func (r *DBInstanceReconciler) correctDrift(ctx context.Context, instance *dbtreev1.DBInstance, prov provisioner.Provisioner) {
+	log := log.FromContext(ctx)
+
+	corrector, ok := prov.(provisioner.DriftCorrector)
+	if !ok {
+		return
 	}
-}
 
-// getBackupImage returns the backup container image for the database type
-func (r *DBInstanceReconciler) getBackupImage(dbType dbtreev1.DBType) string {
-	switch dbType {
-	case dbtreev1.DBTypeMongoDB:
-		return "mongo:7.0"
-	case dbtreev1.DBTypeRedis:
-		return "redis:7.2"
-	default:
-		return "busybox:latest"
+	drifts, err := corrector.CorrectDrift(ctx, instance)
+	for _, drift := range drifts {
+		log.Info("Reverted out-of-band change", "kind", drift.Kind, "name", drift.Name, "field", drift.Field)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DriftCorrected",
+			"Reverted out-of-band change to %s %s (%s)", drift.Kind, drift.Name, drift.Field)
+	}
+	if err != nil {
+		log.Error(err, "Failed to correct drift")
 	}
 }
 
-// getBackupCommand returns the backup command for the database type
-func (r *DBInstanceReconciler) getBackupCommand(dbType dbtreev1.DBType) []string {
-	timestamp := "$(date +%Y%m%d_%H%M%S)"
-
-	switch dbType {
-	case dbtreev1.DBTypeMongoDB:
-		return []string{
-			"/bin/bash", "-c",
-			fmt.Sprintf(`
-#!/bin/bash
-set -e
-
-TIMESTAMP=%s
-BACKUP_DIR="/backup/mongodb-${TIMESTAMP}"
-
-echo "Starting MongoDB backup at ${TIMESTAMP}"
+// recordEvent emits a Kubernetes Event and appends it to status.history.
+// The caller is responsible for persisting the status.
+func (r *DBInstanceReconciler) recordEvent(instance *dbtreev1.DBInstance, eventType, reason, message string) {
+	r.Recorder.Event(instance, eventType, reason, message)
+	instance.AddTransition(eventType, reason, message)
+}
 
-# Create backup
-mongodump \
-  --host="${DB_HOST}" \
-  --port="${DB_PORT}" \
-  --username="${MONGO_INITDB_ROOT_USERNAME}" \
-  --password="${MONGO_INITDB_ROOT_PASSWORD}" \
-  --authenticationDatabase=admin \
-  --out="${BACKUP_DIR}"
+// recordDeleted emits an Event for a resource removed during deletion
+func (r *DBInstanceReconciler) recordDeleted(instance *dbtreev1.DBInstance, kind, name string) {
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, EventReasonResourceDeleted, "Deleted %s %s", kind, name)
+}
 
-# Compress backup
-cd /backup
-tar -czf "mongodb-${TIMESTAMP}.tar.gz" "mongodb-${TIMESTAMP}"
-rm -rf "mongodb-${TIMESTAMP}"
+// recordBackupOutcomes records an Event for every backup job that finished since the last check
+func (r *DBInstanceReconciler) recordBackupOutcomes(ctx context.Context, instance *dbtreev1.DBInstance) {
+	log := log.FromContext(ctx)
 
-echo "Backup completed: mongodb-${TIMESTAMP}.tar.gz"
+	jobList := &batchv1.JobList{}
+	if err := r.List(ctx, jobList, client.InNamespace(instance.GetUserNamespace()),
+		client.MatchingLabels(backupJobLabels(instance))); err != nil {
+		log.Error(err, "Failed to list backup jobs")
+		return
+	}
 
-# Clean old backups
-find /backup -name "mongodb-*.tar.gz" -mtime +${BACKUP_RETENTION_DAYS} -exec rm {} \;
+	jobs := jobList.Items
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreationTimestamp.Before(&jobs[j].CreationTimestamp)
+	})
 
-echo "Cleanup completed"
-`, timestamp),
+	for _, job := range jobs {
+		finishedAt, succeeded, finished := jobResult(&job)
+		if !finished {
+			continue
+		}
+		if instance.Status.LastBackupTime != nil && !instance.Status.LastBackupTime.Before(&finishedAt) {
+			continue
 		}
-	case dbtreev1.DBTypeRedis:
-		return []string{
-			"/bin/bash",
-			"-c",
-			fmt.Sprintf(`
-#!/bin/bash
-set -e
-
-TIMESTAMP=%s
-BACKUP_FILE="/backup/redis-${TIMESTAMP}.rdb"
-
-echo "Starting Redis backup at ${TIMESTAMP}"
-
-# Trigger BGSAVE
-redis-cli -h "${DB_HOST}" -p "${DB_PORT}" BGSAVE
-
-# Wait for backup to complete
-while [ $(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" LASTSAVE) -eq $(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" LASTSAVE) ]; do
-  sleep 1
-done
-
-# Copy dump file
-redis-cli -h "${DB_HOST}" -p "${DB_PORT}" --rdb "${BACKUP_FILE}"
-
-echo "Backup completed: redis-${TIMESTAMP}.rdb"
 
-# Clean old backups
-find /backup -name "redis-*.rdb" -mtime +${BACKUP_RETENTION_DAYS} -exec rm {} \;
+		if succeeded {
+			r.recordEvent(instance, corev1.EventTypeNormal, EventReasonBackupSucceeded,
+				fmt.Sprintf("Backup job %s completed", job.Name))
+		} else {
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonBackupFailed,
+				fmt.Sprintf("Backup job %s failed", job.Name))
+		}
+		instance.Status.LastBackupTime = finishedAt.DeepCopy()
+	}
+}
 
-echo "Cleanup completed"
-`, timestamp),
+// jobResult returns when the job finished and whether it succeeded
+func jobResult(job *batchv1.Job) (metav1.Time, bool, bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return cond.LastTransitionTime, true, true
+		case batchv1.JobFailed:
+			return cond.LastTransitionTime, false, true
 		}
-	default:
-		return []string{"echo", "Backup not supported for this database type"}
 	}
+	return metav1.Time{}, false, false
 }
 
-// updateStatus updates the instance status
+// backupJobLabels returns the labels put on jobs created by the backup CronJob
+func backupJobLabels(instance *dbtreev1.DBInstance) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/instance":  instance.Name,
+		"app.kubernetes.io/component": "backup",
+	}
+}
+
+// updateStatus updates the instance status. ObservedGeneration is left to
+// handleRunning, which sets it only once the spec has been applied
 func (r *DBInstanceReconciler) updateStatus(ctx context.Context, instance *dbtreev1.DBInstance) error {
-	instance.Status.ObservedGeneration = instance.Generation
 	return r.Status().Update(ctx, instance)
 }
 
 // setErrorCondition sets error condition and updates status
 func (r *DBInstanceReconciler) setErrorCondition(ctx context.Context, instance *dbtreev1.DBInstance, reason, message string) (ctrl.Result, error) {
+	// 같은 에러로 재시도할 때마다 이력이 쌓이지 않도록 새 에러만 기록
+	if instance.Status.State != dbtreev1.StatusError || instance.Status.StatusReason != message {
+		instance.Status.State = dbtreev1.StatusError
+		r.recordEvent(instance, corev1.EventTypeWarning, reason, message)
+	}
 	instance.Status.State = dbtreev1.StatusError
 	instance.Status.StatusReason = message
 	instance.SetCondition(ConditionTypeError, metav1.ConditionTrue, reason, message)
@@ -861,6 +1007,8 @@ func (r *DBInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&batchv1.CronJob{}).
+		Owns(&batchv1.Job{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
 		Named("dbinstance").
 		Complete(r)
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+// GatewayConfig configures routing through the shared SNI gateway (Traefik).
+// The gateway terminates TLS with its default certificate (a wildcard for the
+// DB domain stored in the default TLSStore) and forwards plain TCP to the
+// instance Service, so databases never need their own certificates.
+type GatewayConfig struct {
+	// EntryPoint is the Traefik entry point that accepts database connections
+	EntryPoint string
+
+	// Namespace is where the gateway pods run, allowed by the NetworkPolicy
+	Namespace string
+}
+
+var ingressRouteTCPGVK = schema.GroupVersionKind{
+	Group:   "traefik.io",
+	Version: "v1alpha1",
+	Kind:    "IngressRouteTCP",
+}
+
+// reconcileGatewayRoute creates the IngressRouteTCP that maps the instance's
+// SNI hostname to its Service. spec.externalHost 가 비어 있으면 라우트를 삭제
+func (r *DBInstanceReconciler) reconcileGatewayRoute(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) error {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(ingressRouteTCPGVK)
+	route.SetName(instance.GetGatewayRouteName())
+	route.SetNamespace(instance.GetUserNamespace())
+
+	if instance.Spec.ExternalHost == "" {
+		if err := r.Delete(ctx, route); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete gateway route: %w", err)
+		}
+		return nil
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
+		route.SetLabels(map[string]string{
+			"app.kubernetes.io/instance":   instance.Name,
+			"app.kubernetes.io/component":  "gateway-route",
+			"app.kubernetes.io/part-of":    "dbtree",
+			"app.kubernetes.io/managed-by": "dbtree-operator",
+		})
+
+		rule := map[string]interface{}{
+			"match": fmt.Sprintf("HostSNI(`%s`)", instance.Spec.ExternalHost),
+			"services": []interface{}{
+				map[string]interface{}{
+					"name": instance.GetServiceName(),
+					"port": int64(engine.DefaultPort),
+				},
+			},
+		}
+		if len(instance.Spec.AllowedSourceRanges) > 0 {
+			rule["middlewares"] = []interface{}{
+				map[string]interface{}{"name": instance.GetAllowlistName()},
+			}
+		}
+
+		spec := map[string]interface{}{
+			"entryPoints": []interface{}{r.Gateway.EntryPoint},
+			"routes":      []interface{}{rule},
+			// 빈 tls 블록: 게이트웨이 기본 인증서로 TLS 종료
+			"tls": map[string]interface{}{},
+		}
+		if err := unstructured.SetNestedMap(route.Object, spec, "spec"); err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(instance, route, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile gateway route: %w", err)
+	}
+
+	return nil
+}
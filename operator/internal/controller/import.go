@@ -0,0 +1,287 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+const (
+	// AnnotationDataImport marks which import request a job belongs to
+	AnnotationDataImport = "dbtree.cloud/data-import"
+
+	// importDownloadImage fetches the source file before the import container starts
+	importDownloadImage = "curlimages/curl:8.8.0"
+
+	importMountPath = "/import"
+	importFile      = importMountPath + "/payload"
+
+	// importDeadlineSeconds bounds download and load of a single import
+	importDeadlineSeconds = 3600
+
+	// maxImportMessageLength keeps status.dataImport.message small
+	maxImportMessageLength = 1024
+)
+
+// reconcileDataImport runs the import job for spec.dataImport and reports the
+// outcome in status.dataImport. The job downloads the source file into an
+// emptyDir and loads it with the engine's client tools.
+// Returns true while an import is still in progress.
+func (r *DBInstanceReconciler) reconcileDataImport(ctx context.Context, instance *dbtreev1.DBInstance, engine *provisioner.Engine) (bool, error) {
+	requested := instance.Spec.DataImport
+	if requested == nil {
+		return false, nil
+	}
+	current := instance.Status.DataImport
+	if current != nil && current.ID == requested.ID && current.Finished() {
+		return false, nil
+	}
+
+	if engine.Import == nil || !engine.Import.SupportsFormat(requested.Format) {
+		r.finishDataImport(ctx, instance, nil, dbtreev1.DataImportFailed,
+			fmt.Sprintf("%s import is not supported for %s", requested.Format, instance.Spec.Type))
+		return false, nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.GetDataImportJobName(),
+		Namespace: instance.GetUserNamespace(),
+	}, job)
+	if apierrors.IsNotFound(err) {
+		if err := r.createDataImportJob(ctx, instance, engine, requested); err != nil {
+			return false, err
+		}
+		now := metav1.Now()
+		instance.Status.DataImport = &dbtreev1.DataImportStatus{
+			ID:        requested.ID,
+			Phase:     dbtreev1.DataImportRunning,
+			StartedAt: &now,
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get import job: %w", err)
+	}
+
+	// 이전 요청의 Job 이 남아 있으면 지우고 다음 reconcile 에서 새로 생성
+	if job.Annotations[AnnotationDataImport] != requested.ID {
+		return true, r.deleteJob(ctx, job)
+	}
+
+	// Job 생성 후 status 저장만 실패한 경우
+	if current == nil || current.ID != requested.ID {
+		instance.Status.DataImport = &dbtreev1.DataImportStatus{
+			ID:        requested.ID,
+			Phase:     dbtreev1.DataImportRunning,
+			StartedAt: &job.CreationTimestamp,
+		}
+	}
+
+	_, succeeded, finished := jobResult(job)
+	if !finished {
+		return true, nil
+	}
+
+	if !succeeded {
+		r.finishDataImport(ctx, instance, job, dbtreev1.DataImportFailed, r.jobFailureMessage(ctx, job))
+		return false, nil
+	}
+	r.finishDataImport(ctx, instance, job, dbtreev1.DataImportSucceeded, "")
+	return false, nil
+}
+
+// finishDataImport records the outcome of the current import and removes the job
+func (r *DBInstanceReconciler) finishDataImport(ctx context.Context, instance *dbtreev1.DBInstance,
+	job *batchv1.Job, phase dbtreev1.DataImportPhase, message string) {
+	if job != nil {
+		if err := r.deleteJob(ctx, job); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to delete import job", "job", job.Name)
+		}
+	}
+
+	now := metav1.Now()
+	status := &dbtreev1.DataImportStatus{
+		ID:          instance.Spec.DataImport.ID,
+		Phase:       phase,
+		Message:     message,
+		StartedAt:   &now,
+		CompletedAt: &now,
+	}
+	if current := instance.Status.DataImport; current != nil && current.ID == status.ID && current.StartedAt != nil {
+		status.StartedAt = current.StartedAt
+	}
+	instance.Status.DataImport = status
+
+	if phase == dbtreev1.DataImportSucceeded {
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonDataImportSucceeded,
+			fmt.Sprintf("Data import %s completed", status.ID))
+		return
+	}
+	r.recordEvent(instance, corev1.EventTypeWarning, EventReasonDataImportFailed,
+		fmt.Sprintf("Data import %s failed: %s", status.ID, message))
+}
+
+// jobFailureMessage returns the termination message of the failed container of
+// an import or clone job.
+// FallbackToLogsOnError 이므로 명령 출력의 마지막 부분이 담겨 있음
+func (r *DBInstanceReconciler) jobFailureMessage(ctx context.Context, job *batchv1.Job) string {
+	fallback := fmt.Sprintf("Job %s failed", job.Name)
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{"job-name": job.Name}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list job pods", "job", job.Name)
+		return fallback
+	}
+
+	for _, pod := range pods.Items {
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			message := strings.TrimSpace(terminated.Message)
+			if message == "" {
+				message = terminated.Reason
+			}
+			if len(message) > maxImportMessageLength {
+				message = message[len(message)-maxImportMessageLength:]
+			}
+			return fmt.Sprintf("%s: %s", cs.Name, message)
+		}
+	}
+
+	// 파드 없이 실패하면 ActiveDeadlineSeconds 초과
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Message != "" {
+			return cond.Message
+		}
+	}
+	return fallback
+}
+
+// createDataImportJob downloads the source file and runs the engine's import command
+func (r *DBInstanceReconciler) createDataImportJob(ctx context.Context, instance *dbtreev1.DBInstance,
+	engine *provisioner.Engine, spec *dbtreev1.DataImportSpec) error {
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "import", MountPath: importMountPath},
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetDataImportJobName(),
+			Namespace: instance.GetUserNamespace(),
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":  instance.Name,
+				"app.kubernetes.io/component": "data-import",
+				"app.kubernetes.io/part-of":   "dbtree",
+			},
+			Annotations: map[string]string{
+				AnnotationDataImport: spec.ID,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			// 부분 적재 후 재시도하면 중복될 수 있어 재시도하지 않음
+			BackoffLimit:            ptr.To(int32(0)),
+			ActiveDeadlineSeconds:   ptr.To(int64(importDeadlineSeconds)),
+			TTLSecondsAfterFinished: ptr.To(int32(3600)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					AutomountServiceAccountToken: ptr.To(false),
+					InitContainers: []corev1.Container{
+						{
+							Name:  "download",
+							Image: importDownloadImage,
+							Command: []string{
+								"curl", "--fail", "--silent", "--show-error", "--location",
+								"--retry", "3", "--output", importFile, "$(SOURCE_URL)",
+							},
+							Env: []corev1.EnvVar{
+								{Name: "SOURCE_URL", Value: spec.SourceURL},
+							},
+							VolumeMounts:             volumeMounts,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:    "import",
+							Image:   engine.Import.Image,
+							Command: engine.Import.ImportCommand(instance, spec),
+							Env: []corev1.EnvVar{
+								{Name: "DB_HOST", Value: instance.GetServiceName()},
+								{Name: "DB_PORT", Value: fmt.Sprintf("%d", engine.DefaultPort)},
+								{Name: "IMPORT_FILE", Value: importFile},
+								{Name: "IMPORT_DATABASE", Value: spec.Database},
+								{Name: "IMPORT_COLLECTION", Value: spec.Collection},
+							},
+							EnvFrom: []corev1.EnvFromSource{
+								{
+									SecretRef: &corev1.SecretEnvSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: instance.GetSecretName(),
+										},
+									},
+								},
+							},
+							VolumeMounts:             volumeMounts,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "import",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{
+									// 업로드 크기는 백엔드에서 디스크 크기 기준으로 제한됨
+									SizeLimit: ptr.To(resource.MustParse(fmt.Sprintf("%dGi", instance.Spec.Resources.Disk))),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(instance, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create import job: %w", err)
+	}
+	return nil
+}
@@ -36,3 +36,18 @@ type Provisioner interface {
 	// GetStatus retrieves the current status of the database instance
 	GetStatus(ctx context.Context, instance *dbtreev1.DBInstance) (*dbtreev1.DBInstanceStatus, error)
 }
+
+// Drift describes an out-of-band change to an owned resource that was reverted
+type Drift struct {
+	Kind  string
+	Name  string
+	Field string
+}
+
+// DriftCorrector is implemented by provisioners that can detect and revert
+// manual changes to the resources they own
+type DriftCorrector interface {
+	// CorrectDrift compares owned resources with the desired state, reverts
+	// any difference and returns what was reverted
+	CorrectDrift(ctx context.Context, instance *dbtreev1.DBInstance) ([]Drift, error)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mongodb
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+func init() {
+	provisioner.Register(provisioner.Engine{
+		Type: dbtreev1.DBTypeMongoDB,
+		Modes: []dbtreev1.DBMode{
+			dbtreev1.DBModeStandalone,
+			dbtreev1.DBModeReplicaSet,
+			dbtreev1.DBModeSharded,
+		},
+		DefaultPort:    mongoDBPort,
+		NewProvisioner: NewProvisioner,
+		Backup: &provisioner.BackupStrategy{
+			Image:          defaultMongoDBImage,
+			BackupCommand:  backupCommand,
+			RestoreCommand: restoreCommand,
+		},
+		Credentials: &provisioner.CredentialStrategy{
+			Image:         defaultMongoDBImage,
+			RotateCommand: rotateCommand,
+			SecretData:    rotatedSecretData,
+		},
+		Import: &provisioner.ImportStrategy{
+			Image: defaultMongoDBImage,
+			Formats: []dbtreev1.DataImportFormat{
+				dbtreev1.DataImportMongodump,
+				dbtreev1.DataImportJSON,
+				dbtreev1.DataImportCSV,
+			},
+			ImportCommand: importCommand,
+		},
+		Clone: &provisioner.CloneStrategy{
+			Image:          defaultMongoDBImage,
+			CloneCommand:   cloneCommand,
+			RestoreCommand: cloneRestoreCommand,
+		},
+		HealthCheck: healthCheck,
+	})
+}
+
+// healthCheck returns the probe handler for MongoDB pods
+func healthCheck(_ *dbtreev1.DBInstance) corev1.ProbeHandler {
+	return corev1.ProbeHandler{
+		TCPSocket: &corev1.TCPSocketAction{
+			Port: intstr.FromInt32(mongoDBPort),
+		},
+	}
+}
+
+// backupCommand dumps the instance with mongodump and keeps a compressed archive
+func backupCommand() []string {
+	timestamp := "$(date +%Y%m%d_%H%M%S)"
+
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+TIMESTAMP=%s
+BACKUP_DIR="/backup/mongodb-${TIMESTAMP}"
+
+echo "Starting MongoDB backup at ${TIMESTAMP}"
+
+# Create backup
+mongodump \
+  --host="${DB_HOST}" \
+  --port="${DB_PORT}" \
+  --username="${MONGO_INITDB_ROOT_USERNAME}" \
+  --password="${MONGO_INITDB_ROOT_PASSWORD}" \
+  --authenticationDatabase=admin \
+  --out="${BACKUP_DIR}"
+
+# Compress backup
+cd /backup
+tar -czf "mongodb-${TIMESTAMP}.tar.gz" "mongodb-${TIMESTAMP}"
+rm -rf "mongodb-${TIMESTAMP}"
+
+echo "Backup completed: mongodb-${TIMESTAMP}.tar.gz"
+
+# Clean old backups
+find /backup -name "mongodb-*.tar.gz" -mtime +${BACKUP_RETENTION_DAYS} -exec rm {} \;
+
+echo "Cleanup completed"
+`, timestamp),
+	}
+}
+
+// restoreCommand extracts an archive produced by backupCommand and replays it with mongorestore
+func restoreCommand(archive string) []string {
+	return mongorestoreCommand(archive, "")
+}
+
+// cloneRestoreCommand restores another instance's backup into a new instance.
+// 원본의 admin 사용자는 복원하지 않아 새 인스턴스의 root 계정이 유지됨
+func cloneRestoreCommand(archive string) []string {
+	return mongorestoreCommand(archive, `  --nsExclude="admin.system.*" \
+`)
+}
+
+// mongorestoreCommand replays archive with mongorestore, passing extraArgs before the dump directory
+func mongorestoreCommand(archive, extraArgs string) []string {
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+ARCHIVE="/backup/%s"
+WORK_DIR="$(mktemp -d)"
+
+echo "Restoring MongoDB from ${ARCHIVE}"
+
+tar -xzf "${ARCHIVE}" -C "${WORK_DIR}"
+DUMP_DIR="$(find "${WORK_DIR}" -mindepth 1 -maxdepth 1 -type d | head -n 1)"
+
+mongorestore \
+  --host="${DB_HOST}" \
+  --port="${DB_PORT}" \
+  --username="${MONGO_INITDB_ROOT_USERNAME}" \
+  --password="${MONGO_INITDB_ROOT_PASSWORD}" \
+  --authenticationDatabase=admin \
+  --drop \
+%s  "${DUMP_DIR}"
+
+rm -rf "${WORK_DIR}"
+
+echo "Restore completed"
+`, archive, extraArgs),
+	}
+}
+
+// rotateCommand changes the root password with db.changeUserPassword.
+// replica set 은 replicaSet 옵션으로 primary 를 찾아 접속
+func rotateCommand(instance *dbtreev1.DBInstance) []string {
+	options := ""
+	if instance.Spec.Mode == dbtreev1.DBModeReplicaSet {
+		options = "?replicaSet=rs0"
+	}
+
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+URI="mongodb://${DB_HOST}:${DB_PORT}/admin%s"
+
+# 이전 시도에서 이미 변경되었으면 완료로 처리
+if mongosh "${URI}" --quiet \
+  --username="${MONGO_INITDB_ROOT_USERNAME}" \
+  --password="${NEW_PASSWORD}" \
+  --authenticationDatabase=admin \
+  --eval 'db.runCommand({ ping: 1 })' > /dev/null 2>&1; then
+  echo "New password is already active"
+  exit 0
+fi
+
+mongosh "${URI}" --quiet \
+  --username="${MONGO_INITDB_ROOT_USERNAME}" \
+  --password="${CURRENT_PASSWORD}" \
+  --authenticationDatabase=admin \
+  --eval 'db.getSiblingDB("admin").changeUserPassword(process.env.MONGO_INITDB_ROOT_USERNAME, process.env.NEW_PASSWORD)'
+
+echo "Password rotated"
+`, options),
+	}
+}
+
+// rotatedSecretData returns the secret keys that carry the root password
+func rotatedSecretData(instance *dbtreev1.DBInstance, current map[string][]byte, password string) map[string][]byte {
+	username := string(current["username"])
+
+	return map[string][]byte{
+		"password":                   []byte(password),
+		"MONGO_INITDB_ROOT_PASSWORD": []byte(password),
+		"connection-string": []byte(fmt.Sprintf("mongodb://%s:%s@%s:%d/admin",
+			username, password, instance.GetServiceName(), mongoDBPort)),
+	}
+}
+
+// importCommand loads IMPORT_FILE with mongorestore (mongodump archive) or
+// mongoimport (json, csv). replica set 은 replicaSet 옵션으로 primary 에 기록
+func importCommand(instance *dbtreev1.DBInstance, spec *dbtreev1.DataImportSpec) []string {
+	options := ""
+	if instance.Spec.Mode == dbtreev1.DBModeReplicaSet {
+		options = "?replicaSet=rs0"
+	}
+
+	drop := ""
+	if spec.Drop {
+		drop = "--drop"
+	}
+
+	var load string
+	switch spec.Format {
+	case dbtreev1.DataImportMongodump:
+		load = `
+GZIP=""
+if [ "$(head -c 2 "${IMPORT_FILE}" | od -An -tx1 | tr -d ' ')" = "1f8b" ]; then
+  GZIP="--gzip"
+fi
+mongorestore "${URI}" ${AUTH} ${DROP} ${GZIP} --archive="${IMPORT_FILE}"`
+	case dbtreev1.DataImportJSON:
+		load = `
+ARRAY=""
+if [ "$(tr -d ' \t\r\n' < "${IMPORT_FILE}" | head -c 1)" = "[" ]; then
+  ARRAY="--jsonArray"
+fi
+mongoimport "${URI}" ${AUTH} ${DROP} ${ARRAY} \
+  --db="${IMPORT_DATABASE}" --collection="${IMPORT_COLLECTION}" --file="${IMPORT_FILE}"`
+	case dbtreev1.DataImportCSV:
+		load = `
+mongoimport "${URI}" ${AUTH} ${DROP} --type=csv --headerline \
+  --db="${IMPORT_DATABASE}" --collection="${IMPORT_COLLECTION}" --file="${IMPORT_FILE}"`
+	}
+
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+URI="mongodb://${DB_HOST}:${DB_PORT}/%s"
+AUTH="--username=${MONGO_INITDB_ROOT_USERNAME} --password=${MONGO_INITDB_ROOT_PASSWORD} --authenticationDatabase=admin"
+DROP="%s"
+
+echo "Importing ${IMPORT_FILE}"
+%s
+
+echo "Import completed"
+`, options, drop, load),
+	}
+}
+
+// cloneCommand streams a mongodump of the source straight into mongorestore
+func cloneCommand(instance *dbtreev1.DBInstance) []string {
+	// 복제본은 원본과 같은 모드로 생성됨
+	options := ""
+	if instance.Spec.Mode == dbtreev1.DBModeReplicaSet {
+		options = "?replicaSet=rs0"
+	}
+
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -eo pipefail
+
+echo "Cloning ${SOURCE_HOST}"
+
+mongodump "mongodb://${SOURCE_HOST}:${SOURCE_PORT}/%[1]s" \
+  --username="${SOURCE_USERNAME}" --password="${SOURCE_PASSWORD}" --authenticationDatabase=admin \
+  --archive \
+| mongorestore "mongodb://${DB_HOST}:${DB_PORT}/%[1]s" \
+  --username="${MONGO_INITDB_ROOT_USERNAME}" --password="${MONGO_INITDB_ROOT_PASSWORD}" --authenticationDatabase=admin \
+  --drop --nsExclude="admin.system.*" --nsExclude="config.*" --archive
+
+echo "Clone completed"
+`, options),
+	}
+}
@@ -19,7 +19,6 @@ package mongodb
 import (
 	"context"
 	"fmt"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -47,6 +46,8 @@ type MongoDBProvisioner struct {
 	scheme *runtime.Scheme
 }
 
+var _ provisioner.DriftCorrector = (*MongoDBProvisioner)(nil)
+
 // NewProvisioner creates a new MongoDB provisioner
 func NewProvisioner(client client.Client, scheme *runtime.Scheme) provisioner.Provisioner {
 	return &MongoDBProvisioner{
@@ -79,6 +80,12 @@ func (p *MongoDBProvisioner) Provision(ctx context.Context, instance *dbtreev1.D
 		return fmt.Errorf("failed to create statefulset: %w", err)
 	}
 
+	// Create PodDisruptionBudget (multi-member modes only)
+	if err := utils.EnsurePodDisruptionBudget(ctx, p.client, p.scheme, instance,
+		p.getSelectorLabels(instance), p.getReplicas(instance)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -147,18 +154,26 @@ func (p *MongoDBProvisioner) Update(ctx context.Context, instance *dbtreev1.DBIn
 		if err := p.client.Update(ctx, cm); err != nil {
 			return fmt.Errorf("failed to update configmap: %w", err)
 		}
+	}
 
-		// Restart pods to apply config changes
-		// This is done by updating an annotation
+	// Restart pods only when the rendered config or secret reference changed
+	configHash := p.configHash(instance)
+	if sts.Spec.Template.Annotations[utils.AnnotationConfigHash] != configHash {
 		if sts.Spec.Template.Annotations == nil {
 			sts.Spec.Template.Annotations = make(map[string]string)
 		}
-		sts.Spec.Template.Annotations["dbtree.cloud/config-hash"] = fmt.Sprintf("%d", time.Now().Unix())
+		sts.Spec.Template.Annotations[utils.AnnotationConfigHash] = configHash
 		if err := p.client.Update(ctx, sts); err != nil {
 			return fmt.Errorf("failed to trigger pod restart: %w", err)
 		}
 	}
 
+	// Keep the PDB quorum in line with the replica count
+	if err := utils.EnsurePodDisruptionBudget(ctx, p.client, p.scheme, instance,
+		p.getSelectorLabels(instance), desiredReplicas); err != nil {
+		return err
+	}
+
 	// 3. Update Service if port changed
 	if instance.Status.Port != 0 {
 		svc := &corev1.Service{}
@@ -169,9 +184,9 @@ func (p *MongoDBProvisioner) Update(ctx context.Context, instance *dbtreev1.DBIn
 			return fmt.Errorf("failed to get service: %w", err)
 		}
 
-		if svc.Spec.Ports[0].Port != instance.GetDefaultPort() {
-			svc.Spec.Ports[0].Port = instance.GetDefaultPort()
-			svc.Spec.Ports[0].TargetPort = intstr.FromInt32(int32(int(instance.GetDefaultPort())))
+		if svc.Spec.Ports[0].Port != mongoDBPort {
+			svc.Spec.Ports[0].Port = mongoDBPort
+			svc.Spec.Ports[0].TargetPort = intstr.FromInt32(mongoDBPort)
 			if err := p.client.Update(ctx, svc); err != nil {
 				return fmt.Errorf("failed to update service: %w", err)
 			}
@@ -207,6 +222,58 @@ func (p *MongoDBProvisioner) GetStatus(ctx context.Context, instance *dbtreev1.D
 	return status, nil
 }
 
+// CorrectDrift reverts out-of-band changes to the ConfigMap and StatefulSet
+func (p *MongoDBProvisioner) CorrectDrift(ctx context.Context, instance *dbtreev1.DBInstance) ([]provisioner.Drift, error) {
+	namespace := instance.GetUserNamespace()
+	var drifts []provisioner.Drift
+
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, types.NamespacedName{
+		Name:      instance.GetConfigMapName(),
+		Namespace: namespace,
+	}, cm); err != nil {
+		return nil, fmt.Errorf("failed to get configmap: %w", err)
+	}
+
+	desiredConfig := p.generateMongoConfig(instance)
+	if cm.Data["mongod.conf"] != desiredConfig {
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data["mongod.conf"] = desiredConfig
+		if err := p.client.Update(ctx, cm); err != nil {
+			return drifts, fmt.Errorf("failed to revert configmap: %w", err)
+		}
+		drifts = append(drifts, provisioner.Drift{Kind: "ConfigMap", Name: cm.Name, Field: "data[mongod.conf]"})
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := p.client.Get(ctx, types.NamespacedName{
+		Name:      instance.GetStatefulSetName(),
+		Namespace: namespace,
+	}, sts); err != nil {
+		return drifts, fmt.Errorf("failed to get statefulset: %w", err)
+	}
+
+	fields := utils.RevertStatefulSetDrift(sts, utils.DesiredStatefulSet{
+		Container:  "mongodb",
+		Replicas:   p.getReplicas(instance),
+		Image:      p.getImage(instance),
+		Resources:  p.getResourceRequirements(instance),
+		ConfigHash: p.configHash(instance),
+	})
+	if len(fields) > 0 {
+		if err := p.client.Update(ctx, sts); err != nil {
+			return drifts, fmt.Errorf("failed to revert statefulset: %w", err)
+		}
+		for _, field := range fields {
+			drifts = append(drifts, provisioner.Drift{Kind: "StatefulSet", Name: sts.Name, Field: field})
+		}
+	}
+
+	return drifts, nil
+}
+
 // createSecret creates the MongoDB credentials secret
 func (p *MongoDBProvisioner) createSecret(ctx context.Context, instance *dbtreev1.DBInstance, namespace string) error {
 	tempSecretName := instance.Annotations["dbtree.cloud/temp-secret"]
@@ -292,22 +359,25 @@ func (p *MongoDBProvisioner) createService(ctx context.Context, instance *dbtree
 			Labels:    p.getLabels(instance),
 		},
 		Spec: corev1.ServiceSpec{
-			Type:     corev1.ServiceTypeNodePort,
-			Selector: p.getLabels(instance),
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: p.getSelectorLabels(instance),
 			Ports: []corev1.ServicePort{
 				{
 					Name:       "mongodb",
 					Port:       mongoDBPort,
 					TargetPort: intstr.FromInt32(mongoDBPort),
-					NodePort:   instance.Spec.ExternalPort,
 					Protocol:   corev1.ProtocolTCP,
 				},
 			},
 		},
 	}
 
-	// For replica set, create headless service
-	if instance.Spec.Mode == dbtreev1.DBModeReplicaSet {
+	// NodePort 는 백엔드가 포트를 할당한 경우에만 (기본 외부 접속은 SNI 게이트웨이)
+	if instance.Spec.ExternalPort > 0 {
+		svc.Spec.Type = corev1.ServiceTypeNodePort
+		svc.Spec.Ports[0].NodePort = instance.Spec.ExternalPort
+	} else if instance.Spec.Mode == dbtreev1.DBModeReplicaSet {
+		// For replica set, create headless service
 		svc.Spec.ClusterIP = corev1.ClusterIPNone
 	}
 
@@ -318,7 +388,7 @@ func (p *MongoDBProvisioner) createService(ctx context.Context, instance *dbtree
 
 	// Create or update
 	_, err := controllerutil.CreateOrUpdate(ctx, p.client, svc, func() error {
-		svc.Spec.Selector = p.getLabels(instance)
+		svc.Spec.Selector = p.getSelectorLabels(instance)
 		return nil
 	})
 
@@ -350,7 +420,7 @@ func (p *MongoDBProvisioner) createStatefulSet(ctx context.Context, instance *db
 		if sts.CreationTimestamp.IsZero() {
 			sts.Spec.ServiceName = instance.GetServiceName()
 			sts.Spec.Selector = &metav1.LabelSelector{
-				MatchLabels: p.getLabels(instance),
+				MatchLabels: p.getSelectorLabels(instance),
 			}
 			sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
 				{
@@ -371,11 +441,20 @@ func (p *MongoDBProvisioner) createStatefulSet(ctx context.Context, instance *db
 			}
 		}
 
+		// 이전에 만든 StatefulSet 은 셀렉터에 db-size 가 들어 있으므로 파드 라벨이 셀렉터를 벗어나지 않게 유지
+		podLabels := p.getLabels(instance)
+		for key, value := range sts.Spec.Selector.MatchLabels {
+			podLabels[key] = value
+		}
+
 		// 변경 가능한 필드들
 		sts.Spec.Replicas = &replicas
 		sts.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: p.getLabels(instance),
+				Labels: podLabels,
+				Annotations: map[string]string{
+					utils.AnnotationConfigHash: p.configHash(instance),
+				},
 			},
 			Spec: corev1.PodSpec{
 				Containers: []corev1.Container{
@@ -429,20 +508,12 @@ func (p *MongoDBProvisioner) createStatefulSet(ctx context.Context, instance *db
 							},
 						},
 						LivenessProbe: &corev1.Probe{
-							ProbeHandler: corev1.ProbeHandler{
-								TCPSocket: &corev1.TCPSocketAction{
-									Port: intstr.FromInt32(mongoDBPort),
-								},
-							},
+							ProbeHandler:        healthCheck(instance),
 							InitialDelaySeconds: 40,
 							PeriodSeconds:       10,
 						},
 						ReadinessProbe: &corev1.Probe{
-							ProbeHandler: corev1.ProbeHandler{
-								TCPSocket: &corev1.TCPSocketAction{
-									Port: intstr.FromInt32(mongoDBPort),
-								},
-							},
+							ProbeHandler:        healthCheck(instance),
 							InitialDelaySeconds: 40,
 							PeriodSeconds:       10,
 						},
@@ -469,6 +540,12 @@ func (p *MongoDBProvisioner) createStatefulSet(ctx context.Context, instance *db
 			},
 		}
 
+		// 다중 멤버 모드는 노드/zone 에 분산 배치
+		if instance.IsMultiMember() {
+			sts.Spec.Template.Spec.Affinity = utils.PodAntiAffinity(p.getSelectorLabels(instance))
+			sts.Spec.Template.Spec.TopologySpreadConstraints = utils.TopologySpread(p.getSelectorLabels(instance))
+		}
+
 		return nil
 	})
 
@@ -477,6 +554,15 @@ func (p *MongoDBProvisioner) createStatefulSet(ctx context.Context, instance *db
 
 // Helper functions
 
+// getSelectorLabels returns the labels that identify the instance's pods.
+// 셀렉터는 바뀌면 안 되므로 리사이즈로 바뀌는 db-size 같은 라벨은 넣지 않음
+func (p *MongoDBProvisioner) getSelectorLabels(instance *dbtreev1.DBInstance) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     "mongodb",
+		"app.kubernetes.io/instance": instance.Name,
+	}
+}
+
 func (p *MongoDBProvisioner) getLabels(instance *dbtreev1.DBInstance) map[string]string {
 	return map[string]string{
 		"app":                         instance.Name,
@@ -569,11 +655,8 @@ func (p *MongoDBProvisioner) generateMongoConfig(instance *dbtreev1.DBInstance)
 	config, _ := utils.ParseMongoDBConfig(instance.Spec.Config)
 
 	// Basic configuration
+	// systemLog.destination 을 지정하지 않으면 stdout 으로 출력 (kubectl logs / 로그 API 에서 조회)
 	mongoConf := `# MongoDB configuration
-systemLog:
-  destination: file
-  path: /var/log/mongodb/mongod.log
-  logAppend: true
 net:
   port: 27017
   bindIp: 0.0.0.0
@@ -642,12 +725,17 @@ sharding:
 	}
 
 	// Operation Profiling (성능 모니터링)
-	mongoConf += `
+	// mode 와 무관하게 임계값을 넘는 작업은 진단 로그(stdout)에 "Slow query" 로 기록됨
+	profilingMode := "off"
+	if instance.ProfilingEnabled() {
+		profilingMode = "slowOp"
+	}
+	mongoConf += fmt.Sprintf(`
 # Operation Profiling
 operationProfiling:
-  mode: off
-  slowOpThresholdMs: 100
-`
+  mode: %s
+  slowOpThresholdMs: %d
+`, profilingMode, instance.SlowOpThresholdMs())
 
 	// 사이즈별 추가 최적화
 	switch instance.Spec.Size {
@@ -727,3 +815,12 @@ func (p *MongoDBProvisioner) ensureSecret(ctx context.Context, instance *dbtreev
 
 	return nil
 }
+
+// configHash hashes everything that requires a pod restart when changed
+func (p *MongoDBProvisioner) configHash(instance *dbtreev1.DBInstance) string {
+	secretName := ""
+	if instance.Spec.SecretRef != nil {
+		secretName = instance.Spec.SecretRef.Name
+	}
+	return utils.ConfigHash(p.generateMongoConfig(instance), secretName)
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+	"github.com/piper-hyowon/dBtree/operator/internal/provisioner"
+)
+
+func init() {
+	provisioner.Register(provisioner.Engine{
+		Type: dbtreev1.DBTypeRedis,
+		Modes: []dbtreev1.DBMode{
+			dbtreev1.DBModeBasic,
+			dbtreev1.DBModeSentinel,
+			dbtreev1.DBModeCluster,
+		},
+		DefaultPort:    redisPort,
+		NewProvisioner: NewProvisioner,
+		Backup: &provisioner.BackupStrategy{
+			Image:         defaultRedisImage,
+			BackupCommand: backupCommand,
+			// RDB 파일은 서버 시작 시에만 로드되므로 온라인 복원 불가
+			RestoreCommand: nil,
+		},
+		Credentials: &provisioner.CredentialStrategy{
+			Image:         defaultRedisImage,
+			RotateCommand: rotateCommand,
+			SecretData:    rotatedSecretData,
+		},
+		Import: &provisioner.ImportStrategy{
+			Image: defaultRedisImage,
+			// RDB 파일은 시작 시에만 로드되므로 RESP 스트림만 지원
+			Formats:       []dbtreev1.DataImportFormat{dbtreev1.DataImportRESP},
+			ImportCommand: importCommand,
+		},
+		Clone: &provisioner.CloneStrategy{
+			Image:        defaultRedisImage,
+			CloneCommand: cloneCommand,
+		},
+		HealthCheck: healthCheck,
+	})
+}
+
+// healthCheck returns the probe handler for Redis pods
+func healthCheck(_ *dbtreev1.DBInstance) corev1.ProbeHandler {
+	return corev1.ProbeHandler{
+		Exec: &corev1.ExecAction{
+			Command: []string{
+				"redis-cli",
+				"ping",
+			},
+		},
+	}
+}
+
+// backupCommand triggers BGSAVE and copies the resulting RDB file into /backup
+func backupCommand() []string {
+	timestamp := "$(date +%Y%m%d_%H%M%S)"
+
+	return []string{
+		"/bin/bash",
+		"-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+TIMESTAMP=%s
+BACKUP_FILE="/backup/redis-${TIMESTAMP}.rdb"
+
+echo "Starting Redis backup at ${TIMESTAMP}"
+
+# Trigger BGSAVE
+redis-cli -h "${DB_HOST}" -p "${DB_PORT}" BGSAVE
+
+# Wait for backup to complete
+while [ $(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" LASTSAVE) -eq $(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" LASTSAVE) ]; do
+  sleep 1
+done
+
+# Copy dump file
+redis-cli -h "${DB_HOST}" -p "${DB_PORT}" --rdb "${BACKUP_FILE}"
+
+echo "Backup completed: redis-${TIMESTAMP}.rdb"
+
+# Clean old backups
+find /backup -name "redis-*.rdb" -mtime +${BACKUP_RETENTION_DAYS} -exec rm {} \;
+
+echo "Cleanup completed"
+`, timestamp),
+	}
+}
+
+// rotateCommand sets requirepass (and masterauth for replicas) on every member
+// with CONFIG SET. 재시작 시에는 갱신된 Secret 의 REDIS_PASSWORD 로 기동됨
+func rotateCommand(_ *dbtreev1.DBInstance) []string {
+	return []string{
+		"/bin/bash", "-c", `
+#!/bin/bash
+set -e
+
+# headless 서비스면 모든 멤버, 아니면 서비스 IP 하나
+HOSTS="$(getent hosts "${DB_HOST}" | awk '{print $1}')"
+if [ -z "${HOSTS}" ]; then
+  echo "Could not resolve ${DB_HOST}"
+  exit 1
+fi
+
+for HOST in ${HOSTS}; do
+  if [ "$(redis-cli -h "${HOST}" -p "${DB_PORT}" --no-auth-warning -a "${NEW_PASSWORD}" PING)" = "PONG" ]; then
+    echo "${HOST}: new password is already active"
+    continue
+  fi
+
+  [ "$(redis-cli -h "${HOST}" -p "${DB_PORT}" --no-auth-warning -a "${CURRENT_PASSWORD}" CONFIG SET masterauth "${NEW_PASSWORD}")" = "OK" ]
+  [ "$(redis-cli -h "${HOST}" -p "${DB_PORT}" --no-auth-warning -a "${CURRENT_PASSWORD}" CONFIG SET requirepass "${NEW_PASSWORD}")" = "OK" ]
+  echo "${HOST}: password rotated"
+done
+`,
+	}
+}
+
+// rotatedSecretData returns the secret keys that carry the Redis password
+func rotatedSecretData(instance *dbtreev1.DBInstance, _ map[string][]byte, password string) map[string][]byte {
+	return map[string][]byte{
+		"password":       []byte(password),
+		"REDIS_PASSWORD": []byte(password),
+		"connection-string": []byte(fmt.Sprintf("redis://:%s@%s:%d",
+			password, instance.GetServiceName(), redisPort)),
+	}
+}
+
+// importCommand replays a RESP stream with redis-cli --pipe. Drop runs
+// FLUSHALL first. --pipe 는 오류가 있으면 0 이 아닌 코드로 종료
+func importCommand(_ *dbtreev1.DBInstance, spec *dbtreev1.DataImportSpec) []string {
+	flush := ""
+	if spec.Drop {
+		flush = `[ "$(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" --no-auth-warning -a "${REDIS_PASSWORD}" FLUSHALL)" = "OK" ]`
+	}
+
+	return []string{
+		"/bin/bash", "-c",
+		fmt.Sprintf(`
+#!/bin/bash
+set -e
+
+echo "Importing ${IMPORT_FILE}"
+%s
+
+redis-cli -h "${DB_HOST}" -p "${DB_PORT}" --no-auth-warning -a "${REDIS_PASSWORD}" --pipe < "${IMPORT_FILE}"
+
+echo "Import completed"
+`, flush),
+	}
+}
+
+// cloneCommand copies every key of the source with MIGRATE COPY, which the
+// source pushes straight into the new instance in batches of 100 keys
+func cloneCommand(_ *dbtreev1.DBInstance) []string {
+	return []string{
+		"/bin/bash", "-c",
+		`
+#!/bin/bash
+set -eo pipefail
+
+SOURCE="redis-cli -h ${SOURCE_HOST} -p ${SOURCE_PORT} --no-auth-warning -a ${SOURCE_PASSWORD}"
+
+echo "Cloning ${SOURCE_HOST}"
+
+${SOURCE} --scan --count 1000 | xargs -r -d '\n' -n 100 \
+  ${SOURCE} MIGRATE "${DB_HOST}" "${DB_PORT}" "" 0 60000 COPY REPLACE AUTH "${REDIS_PASSWORD}" KEYS
+
+echo "Clone completed: $(redis-cli -h "${DB_HOST}" -p "${DB_PORT}" --no-auth-warning -a "${REDIS_PASSWORD}" DBSIZE) keys"
+`,
+	}
+}
@@ -19,7 +19,6 @@ package redis
 import (
 	"context"
 	"fmt"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -47,6 +46,8 @@ type RedisProvisioner struct {
 	scheme *runtime.Scheme
 }
 
+var _ provisioner.DriftCorrector = (*RedisProvisioner)(nil)
+
 // NewProvisioner creates a new Redis provisioner
 func NewProvisioner(client client.Client, scheme *runtime.Scheme) provisioner.Provisioner {
 	return &RedisProvisioner{
@@ -79,6 +80,12 @@ func (p *RedisProvisioner) Provision(ctx context.Context, instance *dbtreev1.DBI
 		return fmt.Errorf("failed to create statefulset: %w", err)
 	}
 
+	// Create PodDisruptionBudget (multi-member modes only)
+	if err := utils.EnsurePodDisruptionBudget(ctx, p.client, p.scheme, instance,
+		p.getSelectorLabels(instance), p.getReplicas(instance)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -114,8 +121,8 @@ func (p *RedisProvisioner) Update(ctx context.Context, instance *dbtreev1.DBInst
 	}
 
 	// Check replicas (only for cluster mode)
+	desiredReplicas := p.getReplicas(instance)
 	if instance.Spec.Mode == dbtreev1.DBModeCluster || instance.Spec.Mode == dbtreev1.DBModeSentinel {
-		desiredReplicas := p.getReplicas(instance)
 		if *sts.Spec.Replicas != desiredReplicas {
 			sts.Spec.Replicas = &desiredReplicas
 			updateNeeded = true
@@ -145,17 +152,26 @@ func (p *RedisProvisioner) Update(ctx context.Context, instance *dbtreev1.DBInst
 		if err := p.client.Update(ctx, cm); err != nil {
 			return fmt.Errorf("failed to update configmap: %w", err)
 		}
+	}
 
-		// Trigger rolling restart
+	// Restart pods only when the rendered config or secret reference changed
+	configHash := p.configHash(instance)
+	if sts.Spec.Template.Annotations[utils.AnnotationConfigHash] != configHash {
 		if sts.Spec.Template.Annotations == nil {
 			sts.Spec.Template.Annotations = make(map[string]string)
 		}
-		sts.Spec.Template.Annotations["dbtree.cloud/config-hash"] = fmt.Sprintf("%d", time.Now().Unix())
+		sts.Spec.Template.Annotations[utils.AnnotationConfigHash] = configHash
 		if err := p.client.Update(ctx, sts); err != nil {
 			return fmt.Errorf("failed to trigger pod restart: %w", err)
 		}
 	}
 
+	// Keep the PDB quorum in line with the replica count
+	if err := utils.EnsurePodDisruptionBudget(ctx, p.client, p.scheme, instance,
+		p.getSelectorLabels(instance), desiredReplicas); err != nil {
+		return err
+	}
+
 	// 3. Update Service
 	svc := &corev1.Service{}
 	if err := p.client.Get(ctx, types.NamespacedName{
@@ -166,9 +182,9 @@ func (p *RedisProvisioner) Update(ctx context.Context, instance *dbtreev1.DBInst
 	}
 
 	// Check if port needs update
-	if instance.Status.Port != 0 && svc.Spec.Ports[0].Port != instance.GetDefaultPort() {
-		svc.Spec.Ports[0].Port = instance.GetDefaultPort()
-		svc.Spec.Ports[0].TargetPort = intstr.FromInt(int(instance.GetDefaultPort()))
+	if instance.Status.Port != 0 && svc.Spec.Ports[0].Port != redisPort {
+		svc.Spec.Ports[0].Port = redisPort
+		svc.Spec.Ports[0].TargetPort = intstr.FromInt(redisPort)
 		if err := p.client.Update(ctx, svc); err != nil {
 			return fmt.Errorf("failed to update service: %w", err)
 		}
@@ -203,6 +219,58 @@ func (p *RedisProvisioner) GetStatus(ctx context.Context, instance *dbtreev1.DBI
 	return status, nil
 }
 
+// CorrectDrift reverts out-of-band changes to the ConfigMap and StatefulSet
+func (p *RedisProvisioner) CorrectDrift(ctx context.Context, instance *dbtreev1.DBInstance) ([]provisioner.Drift, error) {
+	namespace := instance.GetUserNamespace()
+	var drifts []provisioner.Drift
+
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, types.NamespacedName{
+		Name:      instance.GetConfigMapName(),
+		Namespace: namespace,
+	}, cm); err != nil {
+		return nil, fmt.Errorf("failed to get configmap: %w", err)
+	}
+
+	desiredConfig := p.generateRedisConfig(instance)
+	if cm.Data["redis.conf"] != desiredConfig {
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data["redis.conf"] = desiredConfig
+		if err := p.client.Update(ctx, cm); err != nil {
+			return drifts, fmt.Errorf("failed to revert configmap: %w", err)
+		}
+		drifts = append(drifts, provisioner.Drift{Kind: "ConfigMap", Name: cm.Name, Field: "data[redis.conf]"})
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := p.client.Get(ctx, types.NamespacedName{
+		Name:      instance.GetStatefulSetName(),
+		Namespace: namespace,
+	}, sts); err != nil {
+		return drifts, fmt.Errorf("failed to get statefulset: %w", err)
+	}
+
+	fields := utils.RevertStatefulSetDrift(sts, utils.DesiredStatefulSet{
+		Container:  "redis",
+		Replicas:   p.getReplicas(instance),
+		Image:      p.getImage(instance),
+		Resources:  p.getResourceRequirements(instance),
+		ConfigHash: p.configHash(instance),
+	})
+	if len(fields) > 0 {
+		if err := p.client.Update(ctx, sts); err != nil {
+			return drifts, fmt.Errorf("failed to revert statefulset: %w", err)
+		}
+		for _, field := range fields {
+			drifts = append(drifts, provisioner.Drift{Kind: "StatefulSet", Name: sts.Name, Field: field})
+		}
+	}
+
+	return drifts, nil
+}
+
 // createSecret creates the Redis password secret
 func (p *RedisProvisioner) createSecret(ctx context.Context, instance *dbtreev1.DBInstance, namespace string) error {
 	// Check if secret already exists
@@ -279,7 +347,7 @@ func (p *RedisProvisioner) createService(ctx context.Context, instance *dbtreev1
 		},
 		Spec: corev1.ServiceSpec{
 			Type:     corev1.ServiceTypeClusterIP,
-			Selector: p.getLabels(instance),
+			Selector: p.getSelectorLabels(instance),
 			Ports: []corev1.ServicePort{
 				{
 					Name:       "redis",
@@ -303,7 +371,7 @@ func (p *RedisProvisioner) createService(ctx context.Context, instance *dbtreev1
 
 	// Create or update
 	_, err := controllerutil.CreateOrUpdate(ctx, p.client, svc, func() error {
-		svc.Spec.Selector = p.getLabels(instance)
+		svc.Spec.Selector = p.getSelectorLabels(instance)
 		return nil
 	})
 
@@ -324,11 +392,14 @@ func (p *RedisProvisioner) createStatefulSet(ctx context.Context, instance *dbtr
 			ServiceName: instance.GetServiceName(),
 			Replicas:    &replicas,
 			Selector: &metav1.LabelSelector{
-				MatchLabels: p.getLabels(instance),
+				MatchLabels: p.getSelectorLabels(instance),
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: p.getLabels(instance),
+					Annotations: map[string]string{
+						utils.AnnotationConfigHash: p.configHash(instance),
+					},
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
@@ -359,26 +430,12 @@ func (p *RedisProvisioner) createStatefulSet(ctx context.Context, instance *dbtr
 							VolumeMounts: p.getVolumeMounts(instance),
 							Command:      p.getCommand(instance),
 							LivenessProbe: &corev1.Probe{
-								ProbeHandler: corev1.ProbeHandler{
-									Exec: &corev1.ExecAction{
-										Command: []string{
-											"redis-cli",
-											"ping",
-										},
-									},
-								},
+								ProbeHandler:        healthCheck(instance),
 								InitialDelaySeconds: 30,
 								PeriodSeconds:       10,
 							},
 							ReadinessProbe: &corev1.Probe{
-								ProbeHandler: corev1.ProbeHandler{
-									Exec: &corev1.ExecAction{
-										Command: []string{
-											"redis-cli",
-											"ping",
-										},
-									},
-								},
+								ProbeHandler:        healthCheck(instance),
 								InitialDelaySeconds: 5,
 								PeriodSeconds:       5,
 							},
@@ -402,6 +459,12 @@ func (p *RedisProvisioner) createStatefulSet(ctx context.Context, instance *dbtr
 		},
 	}
 
+	// 다중 멤버 모드는 노드/zone 에 분산 배치
+	if instance.IsMultiMember() {
+		sts.Spec.Template.Spec.Affinity = utils.PodAntiAffinity(p.getSelectorLabels(instance))
+		sts.Spec.Template.Spec.TopologySpreadConstraints = utils.TopologySpread(p.getSelectorLabels(instance))
+	}
+
 	// Set owner reference
 	if err := controllerutil.SetControllerReference(instance, sts, p.scheme); err != nil {
 		return err
@@ -411,6 +474,10 @@ func (p *RedisProvisioner) createStatefulSet(ctx context.Context, instance *dbtr
 	_, err := controllerutil.CreateOrUpdate(ctx, p.client, sts, func() error {
 		// Update mutable fields
 		sts.Spec.Template.Spec.Containers[0].Resources = p.getResourceRequirements(instance)
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = make(map[string]string)
+		}
+		sts.Spec.Template.Annotations[utils.AnnotationConfigHash] = p.configHash(instance)
 		return nil
 	})
 
@@ -419,6 +486,15 @@ func (p *RedisProvisioner) createStatefulSet(ctx context.Context, instance *dbtr
 
 // Helper functions
 
+// getSelectorLabels returns the labels that identify the instance's pods.
+// 셀렉터는 바뀌면 안 되므로 리사이즈로 바뀌는 db-size 같은 라벨은 넣지 않음
+func (p *RedisProvisioner) getSelectorLabels(instance *dbtreev1.DBInstance) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     "redis",
+		"app.kubernetes.io/instance": instance.Name,
+	}
+}
+
 func (p *RedisProvisioner) getLabels(instance *dbtreev1.DBInstance) map[string]string {
 	return map[string]string{
 		"app.kubernetes.io/name":      "redis",
@@ -578,6 +654,15 @@ loglevel notice
 logfile ""
 `, maxMemory)
 
+	// SLOWLOG 임계값 (마이크로초), 꺼져 있으면 Redis 기본값(10ms, 128개) 사용
+	if instance.ProfilingEnabled() {
+		redisConf += fmt.Sprintf(`
+# Slow log
+slowlog-log-slower-than %d
+slowlog-max-len 1024
+`, int64(instance.SlowOpThresholdMs())*1000)
+	}
+
 	return redisConf
 }
 
@@ -588,3 +673,8 @@ func (p *RedisProvisioner) isPersistenceEnabled(instance *dbtreev1.DBInstance) b
 	}
 	return true // Default to enabled
 }
+
+// configHash hashes everything that requires a pod restart when changed
+func (p *RedisProvisioner) configHash(instance *dbtreev1.DBInstance) string {
+	return utils.ConfigHash(p.generateRedisConfig(instance), instance.GetSecretName())
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+)
+
+// Factory creates a Provisioner bound to the manager's client and scheme
+type Factory func(client client.Client, scheme *runtime.Scheme) Provisioner
+
+// BackupStrategy describes how backups of an engine are taken and restored.
+// Commands run in Image with the backup PVC mounted at /backup and the
+// instance secret exposed as environment variables (DB_HOST, DB_PORT 포함).
+type BackupStrategy struct {
+	// Image is the container image used by backup and restore jobs
+	Image string
+
+	// BackupCommand returns the command that writes a new archive into /backup
+	BackupCommand func() []string
+
+	// RestoreCommand returns the command that restores the given archive
+	// (relative to /backup) into the running instance.
+	// nil이면 온라인 복원을 지원하지 않는 엔진
+	RestoreCommand func(archive string) []string
+}
+
+// CredentialStrategy describes how the root password of a running instance is
+// rotated in place. RotateCommand runs in Image with CURRENT_PASSWORD and
+// NEW_PASSWORD set, plus DB_HOST, DB_PORT and the instance secret.
+// 재시도될 수 있으므로 새 비밀번호가 이미 적용된 상태에서도 성공해야 함
+type CredentialStrategy struct {
+	// Image is the container image used by the rotation job
+	Image string
+
+	// RotateCommand returns the command that changes the password on the live database
+	RotateCommand func(instance *dbtreev1.DBInstance) []string
+
+	// SecretData returns the secret keys to overwrite once the new password is
+	// live. current is the secret's data before the rotation
+	SecretData func(instance *dbtreev1.DBInstance, current map[string][]byte, password string) map[string][]byte
+}
+
+// ImportStrategy describes how an uploaded file is loaded into a running
+// instance. ImportCommand runs in Image with the downloaded file at
+// IMPORT_FILE, plus DB_HOST, DB_PORT and the instance secret.
+type ImportStrategy struct {
+	// Image is the container image used by import jobs
+	Image string
+
+	// Formats lists the accepted import formats
+	Formats []dbtreev1.DataImportFormat
+
+	// ImportCommand returns the command that loads IMPORT_FILE into the instance
+	ImportCommand func(instance *dbtreev1.DBInstance, spec *dbtreev1.DataImportSpec) []string
+}
+
+// SupportsFormat reports whether the strategy accepts the given format
+func (s *ImportStrategy) SupportsFormat(format dbtreev1.DataImportFormat) bool {
+	for _, f := range s.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// CloneStrategy describes how a new instance is filled with the data of
+// another instance of the same engine, live or from one of its backups.
+// CloneCommand runs in Image with
+// SOURCE_HOST, SOURCE_PORT, SOURCE_USERNAME and SOURCE_PASSWORD for the source,
+// plus DB_HOST, DB_PORT and the new instance's secret.
+type CloneStrategy struct {
+	// Image is the container image used by clone jobs
+	Image string
+
+	// CloneCommand returns the command that copies the source into the instance
+	CloneCommand func(instance *dbtreev1.DBInstance) []string
+
+	// RestoreCommand returns the command that restores an archive of the
+	// source's backup PVC (mounted at /backup) into the instance.
+	// nil이면 백업에서 복제를 지원하지 않는 엔진
+	RestoreCommand func(archive string) []string
+}
+
+// Engine describes everything the controller needs to know about a database
+// engine. Each engine package registers itself from init(), so adding a new
+// engine only requires a new package and a blank import in cmd/main.go.
+type Engine struct {
+	// Type is the DBType handled by this engine
+	Type dbtreev1.DBType
+
+	// Modes lists the deployment modes the engine supports
+	Modes []dbtreev1.DBMode
+
+	// DefaultPort is the port the database listens on inside the cluster
+	DefaultPort int32
+
+	// NewProvisioner creates the provisioner for this engine
+	NewProvisioner Factory
+
+	// Backup is the backup/restore strategy. nil means backups are unsupported
+	Backup *BackupStrategy
+
+	// Credentials is the password rotation strategy. nil means rotation is unsupported
+	Credentials *CredentialStrategy
+
+	// Import is the data import strategy. nil means imports are unsupported
+	Import *ImportStrategy
+
+	// Clone is the clone strategy. nil means cloning is unsupported
+	Clone *CloneStrategy
+
+	// HealthCheck returns the probe handler used for liveness and readiness
+	HealthCheck func(instance *dbtreev1.DBInstance) corev1.ProbeHandler
+}
+
+// SupportsMode reports whether the engine can run in the given mode
+func (e *Engine) SupportsMode(mode dbtreev1.DBMode) bool {
+	for _, m := range e.Modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	enginesMu sync.RWMutex
+	engines   = make(map[dbtreev1.DBType]*Engine)
+)
+
+// Register makes an engine available to the controller.
+// It panics if called twice for the same type or with an incomplete engine.
+func Register(engine Engine) {
+	if engine.Type == "" || engine.NewProvisioner == nil {
+		panic("provisioner: Register called with incomplete engine")
+	}
+	if engine.HealthCheck == nil {
+		panic(fmt.Sprintf("provisioner: engine %s has no health check", engine.Type))
+	}
+
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+
+	if _, dup := engines[engine.Type]; dup {
+		panic(fmt.Sprintf("provisioner: Register called twice for engine %s", engine.Type))
+	}
+	engines[engine.Type] = &engine
+}
+
+// Lookup returns the registered engine for the database type
+func Lookup(dbType dbtreev1.DBType) (*Engine, bool) {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+
+	engine, ok := engines[dbType]
+	return engine, ok
+}
+
+// Engines returns all registered engines sorted by type
+func Engines() []*Engine {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+
+	list := make([]*Engine, 0, len(engines))
+	for _, engine := range engines {
+		list = append(list, engine)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Type < list[j].Type
+	})
+	return list
+}
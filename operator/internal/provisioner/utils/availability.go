@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dbtreev1 "github.com/piper-hyowon/dBtree/operator/api/v1"
+)
+
+// Anti-affinity와 zone spread 는 soft 규칙(preferred/ScheduleAnyway)
+// 노드가 하나뿐이거나 zone 라벨이 없는 클러스터에서도 파드가 Pending 되지 않도록 함
+const antiAffinityWeight = 100
+
+// PodAntiAffinity spreads members of the same instance across nodes
+func PodAntiAffinity(selector map[string]string) *corev1.Affinity {
+	return &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: antiAffinityWeight,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{MatchLabels: selector},
+						TopologyKey:   corev1.LabelHostname,
+					},
+				},
+			},
+		},
+	}
+}
+
+// TopologySpread spreads members of the same instance across zones
+func TopologySpread(selector map[string]string) []corev1.TopologySpreadConstraint {
+	return []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: selector},
+		},
+	}
+}
+
+// QuorumSize returns the number of members that must stay up to keep a majority
+func QuorumSize(replicas int32) int32 {
+	return replicas/2 + 1
+}
+
+// EnsurePodDisruptionBudget keeps a quorum PDB for multi-member instances.
+// 단일 멤버 모드에서는 PDB 를 만들지 않고, 남아 있으면 삭제함
+func EnsurePodDisruptionBudget(ctx context.Context, c client.Client, scheme *runtime.Scheme,
+	instance *dbtreev1.DBInstance, selector map[string]string, replicas int32) error {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetPodDisruptionBudgetName(),
+			Namespace: instance.GetUserNamespace(),
+		},
+	}
+
+	if !instance.IsMultiMember() || replicas < 2 {
+		if err := c.Delete(ctx, pdb); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete poddisruptionbudget: %w", err)
+		}
+		return nil
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, c, pdb, func() error {
+		pdb.Labels = selector
+		minAvailable := intstr.FromInt32(QuorumSize(replicas))
+		pdb.Spec.MinAvailable = &minAvailable
+		pdb.Spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+		return controllerutil.SetControllerReference(instance, pdb, scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile poddisruptionbudget: %w", err)
+	}
+	return nil
+}
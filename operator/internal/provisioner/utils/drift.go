@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// DesiredStatefulSet holds the StatefulSet fields owned by the operator
+type DesiredStatefulSet struct {
+	Container  string
+	Replicas   int32
+	Image      string
+	Resources  corev1.ResourceRequirements
+	ConfigHash string
+}
+
+// RevertStatefulSetDrift resets owned fields of sts that differ from desired
+// and returns the list of reverted fields. The caller is responsible for
+// persisting sts when the returned list is not empty.
+func RevertStatefulSetDrift(sts *appsv1.StatefulSet, desired DesiredStatefulSet) []string {
+	var reverted []string
+
+	if sts.Spec.Replicas == nil || *sts.Spec.Replicas != desired.Replicas {
+		replicas := desired.Replicas
+		sts.Spec.Replicas = &replicas
+		reverted = append(reverted, "spec.replicas")
+	}
+
+	if sts.Spec.Template.Annotations[AnnotationConfigHash] != desired.ConfigHash {
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = make(map[string]string)
+		}
+		sts.Spec.Template.Annotations[AnnotationConfigHash] = desired.ConfigHash
+		reverted = append(reverted, fmt.Sprintf("spec.template.metadata.annotations[%s]", AnnotationConfigHash))
+	}
+
+	for i := range sts.Spec.Template.Spec.Containers {
+		container := &sts.Spec.Template.Spec.Containers[i]
+		if container.Name != desired.Container {
+			continue
+		}
+
+		if container.Image != desired.Image {
+			container.Image = desired.Image
+			reverted = append(reverted, fmt.Sprintf("containers[%s].image", container.Name))
+		}
+		if !equality.Semantic.DeepEqual(container.Resources, desired.Resources) {
+			container.Resources = desired.Resources
+			reverted = append(reverted, fmt.Sprintf("containers[%s].resources", container.Name))
+		}
+	}
+
+	return reverted
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// AnnotationConfigHash is set on the pod template so that pods restart only
+// when the rendered configuration or the referenced secrets change
+const AnnotationConfigHash = "dbtree.cloud/config-hash"
+
+// ConfigHash returns a deterministic content hash of the given parts
+// (rendered config files, secret names, ...). 순서가 같으면 항상 같은 값
+func ConfigHash(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		// 구분자를 넣어 ("ab","c") 와 ("a","bc") 가 같은 해시가 되지 않도록 함
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}